@@ -0,0 +1,125 @@
+package teamwork_test
+
+import (
+	"flag"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/swill/teamwork"
+	"github.com/swill/teamwork/teamworktest"
+)
+
+// record re-records the golden files in testdata against a teamworktest.Server
+// seeded by seedFixtures, eg: go test -run Example -record
+var record = flag.Bool("record", false, "record the golden files in testdata")
+
+// exampleURL is the TeamWork URL used by the examples.
+const exampleURL = "https://example.teamwork.com"
+
+// cassette returns an http.Client which replays the golden file of an
+// example, or records it when the -record flag is set.
+func cassette(name string) *http.Client {
+	path := filepath.Join("testdata", name+".json")
+	if !*record {
+		recorder, err := teamworktest.NewRecorder(path, teamworktest.Replay)
+		if err != nil {
+			panic(err)
+		}
+		return &http.Client{Transport: recorder}
+	}
+
+	server := fixtureServer()
+	target, _ := url.Parse(server.URL)
+	recorder, err := teamworktest.NewRecorder(path, teamworktest.Record)
+	if err != nil {
+		panic(err)
+	}
+	recorder.Transport = redirectTransport{target: target}
+	recorder.Scrub = map[string]string{server.URL: exampleURL}
+	return &http.Client{Transport: recorder}
+}
+
+// redirectTransport sends every request to the target host.
+type redirectTransport struct {
+	target *url.URL
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	req.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+var (
+	fixtureOnce sync.Once
+	fixtures    *teamworktest.Server
+)
+
+// fixtureServer starts the teamworktest.Server the golden files are recorded
+// against.  It is left running until the tests exit.
+func fixtureServer() *teamworktest.Server {
+	fixtureOnce.Do(func() {
+		fixtures = teamworktest.NewServer()
+		fixtures.Token = "" // the examples use a made up token
+		seedFixtures(fixtures)
+	})
+	return fixtures
+}
+
+// seedFixtures seeds the data the examples are recorded against.
+func seedFixtures(server *teamworktest.Server) {
+	created := time.Date(2020, 6, 3, 15, 21, 0, 0, time.UTC)
+	createdOn := teamwork.Time{Time: created}
+
+	server.AddProjects(
+		teamwork.Project{ID: "158721", Name: "Website Redesign", Status: "active", CreatedOn: created, LastChangedOn: created},
+		teamwork.Project{ID: "158747", Name: "Mobile App", Status: "active", CreatedOn: created, LastChangedOn: created, People: []teamwork.ID{"85457", "85458"}},
+	)
+
+	server.AddPeople(
+		teamwork.Person{ID: "85457", UserName: "jdoe", FirstName: "Jane", LastName: "Doe", CompanyID: "12345", CompanyName: "Acme", Projects: []teamwork.ID{"158721", "158747"}, CreatedAt: created},
+		teamwork.Person{ID: "85458", UserName: "jsmith", FirstName: "John", LastName: "Smith", CompanyID: "12345", CompanyName: "Acme", Projects: []teamwork.ID{"158747"}, CreatedAt: created},
+	)
+
+	server.AddTaskLists(
+		teamwork.TaskList{ID: "704748", Name: "Design", ProjectID: "158721", ProjectName: "Website Redesign", Status: "new"},
+		teamwork.TaskList{ID: "704749", Name: "Build", ProjectID: "158721", ProjectName: "Website Redesign", Status: "new"},
+	)
+
+	server.AddTasks(
+		teamwork.Task{ID: "4486838", Content: "Draft the wireframes", Status: "new", ProjectID: "158721", ProjectName: "Website Redesign", TaskListID: "704748", TaskListName: "Design", CreatedOn: createdOn, LastChangedOn: createdOn},
+		teamwork.Task{ID: "4754100", Content: "Pick a colour palette", Status: "completed", ProjectID: "158721", ProjectName: "Website Redesign", TaskListID: "704748", TaskListName: "Design", CreatedOn: createdOn, LastChangedOn: createdOn},
+		teamwork.Task{ID: "4754101", Content: "Set up the build", Status: "new", ProjectID: "158721", ProjectName: "Website Redesign", TaskListID: "704749", TaskListName: "Build", CreatedOn: createdOn, LastChangedOn: createdOn},
+	)
+
+	server.AddTimeEntries(
+		teamwork.TimeEntry{ID: "1001", CompanyName: "Acme", ProjectID: "158721", ProjectName: "Website Redesign", Description: "Wireframe review", Hours: "2", Minutes: "30", TaskItemID: "4486838", TaskItemName: "Draft the wireframes", TaskListName: "Design", Date: created, CreatedAt: created, UpdatedDate: created},
+		teamwork.TimeEntry{ID: "1002", CompanyName: "Acme", ProjectID: "158721", ProjectName: "Website Redesign", Description: "Colour research", Hours: "1", Minutes: "0", TaskItemID: "4754100", TaskItemName: "Pick a colour palette", TaskListName: "Design", Date: created, CreatedAt: created, UpdatedDate: created},
+	)
+
+	server.SetTotalTime(teamwork.TotalTime{TotalHoursSum: "3.50", BillableHoursSum: "2.50"})
+	projectTotal := teamwork.ProjectTotalTime{ID: "158721", Name: "Website Redesign"}
+	projectTotal.TimeTotals.TotalHoursSum = "3.50"
+	projectTotal.TimeTotals.BillableHoursSum = "2.50"
+	server.AddProjectTotalTimes(projectTotal)
+	taskListTotal := teamwork.ProjectTaskListTotalTime{ID: "158721", Name: "Website Redesign"}
+	taskListTotal.TaskList.ID = "704748"
+	taskListTotal.TaskList.Name = "Design"
+	taskListTotal.TaskList.TimeTotals.TotalHoursSum = "3.50"
+	taskListTotal.TaskList.TimeTotals.BillableHoursSum = "2.50"
+	server.AddTaskListTotalTimes(taskListTotal)
+	taskTotal := teamwork.ProjectTaskTotalTime{ID: "158721", Name: "Website Redesign"}
+	taskTotal.TaskList.ID = "704748"
+	taskTotal.TaskList.Name = "Design"
+	taskTotal.TaskList.Task.ID = "4486838"
+	taskTotal.TaskList.Task.Name = "Draft the wireframes"
+	taskTotal.TaskList.Task.TimeTotals.TotalHoursSum = "2.50"
+	taskTotal.TaskList.Task.TimeTotals.BillableHoursSum = "2.50"
+	server.AddTaskTotalTimes(taskTotal)
+}
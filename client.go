@@ -0,0 +1,250 @@
+package teamwork
+
+import (
+	"context"
+	"io"
+	"iter"
+)
+
+// The services below describe the calls a Connection makes to TeamWork, so
+// code using this package can depend on an interface and be tested with a
+// mock, such as the one in the teamworkmock package.
+
+// ProjectsService describes the projects calls of the TeamWork API.
+type ProjectsService interface {
+	GetProjects(ops *GetProjectsOps) (Projects, Pages, error)
+	GetProjectsContext(ctx context.Context, ops *GetProjectsOps) (Projects, Pages, error)
+	AllProjects(ctx context.Context, ops *GetProjectsOps) iter.Seq2[Project, error]
+	GetAllProjects(ops *GetProjectsOps) (Projects, error)
+	GetAllProjectsContext(ctx context.Context, ops *GetProjectsOps) (Projects, error)
+	GetProject(id string, ops *GetProjectOps) (Project, error)
+	GetProjectContext(ctx context.Context, id string, ops *GetProjectOps) (Project, error)
+	CreateProject(ops *CreateProjectOps) (*CreateProjectResponse, error)
+	CreateProjectContext(ctx context.Context, ops *CreateProjectOps) (*CreateProjectResponse, error)
+	UpdateProject(id string, ops *UpdateProjectOps) (*StatusResponse, error)
+	UpdateProjectContext(ctx context.Context, id string, ops *UpdateProjectOps) (*StatusResponse, error)
+	DeleteProject(id string) (*StatusResponse, error)
+	DeleteProjectContext(ctx context.Context, id string) (*StatusResponse, error)
+	ArchiveProject(id string) (*StatusResponse, error)
+	ArchiveProjectContext(ctx context.Context, id string) (*StatusResponse, error)
+	ReactivateProject(id string) (*StatusResponse, error)
+	ReactivateProjectContext(ctx context.Context, id string) (*StatusResponse, error)
+	StarProject(id string) (*StatusResponse, error)
+	StarProjectContext(ctx context.Context, id string) (*StatusResponse, error)
+	UnstarProject(id string) (*StatusResponse, error)
+	UnstarProjectContext(ctx context.Context, id string) (*StatusResponse, error)
+	AddPeopleToProject(projectID string, personIDs []string) (*StatusResponse, error)
+	AddPeopleToProjectContext(ctx context.Context, projectID string, personIDs []string) (*StatusResponse, error)
+	RemovePersonFromProject(projectID, personID string) (*StatusResponse, error)
+	RemovePersonFromProjectContext(ctx context.Context, projectID, personID string) (*StatusResponse, error)
+	SetProjectPeople(projectID string, personIDs []string) (*StatusResponse, error)
+	SetProjectPeopleContext(ctx context.Context, projectID string, personIDs []string) (*StatusResponse, error)
+	UpdateProjectPermissions(projectID, personID string, perms *ProjectPermissions) (*StatusResponse, error)
+	UpdateProjectPermissionsContext(ctx context.Context, projectID, personID string, perms *ProjectPermissions) (*StatusResponse, error)
+}
+
+// PeopleService describes the people calls of the TeamWork API.
+type PeopleService interface {
+	GetPeople(ops *GetPeopleOps) (People, Pages, error)
+	GetPeopleContext(ctx context.Context, ops *GetPeopleOps) (People, Pages, error)
+	AllPeople(ctx context.Context, ops *GetPeopleOps) iter.Seq2[Person, error]
+	GetAllPeople(ops *GetPeopleOps) (People, error)
+	GetAllPeopleContext(ctx context.Context, ops *GetPeopleOps) (People, error)
+	GetProjectPeople(id string, ops *GetPeopleOps) (People, Pages, error)
+	GetProjectPeopleContext(ctx context.Context, id string, ops *GetPeopleOps) (People, Pages, error)
+	AllProjectPeople(ctx context.Context, id string, ops *GetPeopleOps) iter.Seq2[Person, error]
+	GetAllProjectPeople(id string, ops *GetPeopleOps) (People, error)
+	GetAllProjectPeopleContext(ctx context.Context, id string, ops *GetPeopleOps) (People, error)
+	GetCompanyPeople(id string, ops *GetPeopleOps) (People, Pages, error)
+	GetCompanyPeopleContext(ctx context.Context, id string, ops *GetPeopleOps) (People, Pages, error)
+	AllCompanyPeople(ctx context.Context, id string, ops *GetPeopleOps) iter.Seq2[Person, error]
+	GetAllCompanyPeople(id string, ops *GetPeopleOps) (People, error)
+	GetAllCompanyPeopleContext(ctx context.Context, id string, ops *GetPeopleOps) (People, error)
+	GetPerson(id string) (Person, error)
+	GetPersonContext(ctx context.Context, id string) (Person, error)
+	GetCurrentPerson() (Person, error)
+	GetCurrentPersonContext(ctx context.Context) (Person, error)
+	CreatePerson(ops *CreatePersonOps) (*CreatePersonResponse, error)
+	CreatePersonContext(ctx context.Context, ops *CreatePersonOps) (*CreatePersonResponse, error)
+	InvitePerson(ops *CreatePersonOps) (*CreatePersonResponse, error)
+	InvitePersonContext(ctx context.Context, ops *CreatePersonOps) (*CreatePersonResponse, error)
+	UpdatePerson(id string, ops *UpdatePersonOps) (*StatusResponse, error)
+	UpdatePersonContext(ctx context.Context, id string, ops *UpdatePersonOps) (*StatusResponse, error)
+	SetPersonAdministrator(id string, administrator bool) (*StatusResponse, error)
+	SetPersonAdministratorContext(ctx context.Context, id string, administrator bool) (*StatusResponse, error)
+	DeletePerson(id string) (*StatusResponse, error)
+	DeletePersonContext(ctx context.Context, id string) (*StatusResponse, error)
+}
+
+// CompaniesService describes the company calls of the TeamWork API.
+type CompaniesService interface {
+	GetCompanies(ops *GetCompaniesOps) (Companies, Pages, error)
+	GetCompaniesContext(ctx context.Context, ops *GetCompaniesOps) (Companies, Pages, error)
+	AllCompanies(ctx context.Context, ops *GetCompaniesOps) iter.Seq2[Company, error]
+	GetAllCompanies(ops *GetCompaniesOps) (Companies, error)
+	GetAllCompaniesContext(ctx context.Context, ops *GetCompaniesOps) (Companies, error)
+	GetCompany(id string) (Company, error)
+	GetCompanyContext(ctx context.Context, id string) (Company, error)
+	GetCompanyProjects(id string, ops *GetProjectsOps) (Projects, Pages, error)
+	GetCompanyProjectsContext(ctx context.Context, id string, ops *GetProjectsOps) (Projects, Pages, error)
+	AllCompanyProjects(ctx context.Context, id string, ops *GetProjectsOps) iter.Seq2[Project, error]
+	GetAllCompanyProjects(id string, ops *GetProjectsOps) (Projects, error)
+	GetAllCompanyProjectsContext(ctx context.Context, id string, ops *GetProjectsOps) (Projects, error)
+	CreateCompany(ops *CreateCompanyOps) (*CreateCompanyResponse, error)
+	CreateCompanyContext(ctx context.Context, ops *CreateCompanyOps) (*CreateCompanyResponse, error)
+	UpdateCompany(id string, ops *UpdateCompanyOps) (*StatusResponse, error)
+	UpdateCompanyContext(ctx context.Context, id string, ops *UpdateCompanyOps) (*StatusResponse, error)
+	DeleteCompany(id string) (*StatusResponse, error)
+	DeleteCompanyContext(ctx context.Context, id string) (*StatusResponse, error)
+}
+
+// TasksService describes the task and task list calls of the TeamWork API.
+type TasksService interface {
+	GetTasks(ops *GetTasksOps) (Tasks, Pages, error)
+	GetTasksContext(ctx context.Context, ops *GetTasksOps) (Tasks, Pages, error)
+	AllTasks(ctx context.Context, ops *GetTasksOps) iter.Seq2[Task, error]
+	GetAllTasks(ops *GetTasksOps) (Tasks, error)
+	GetAllTasksContext(ctx context.Context, ops *GetTasksOps) (Tasks, error)
+	GetProjectTasks(id string, ops *GetTasksOps) (Tasks, Pages, error)
+	GetProjectTasksContext(ctx context.Context, id string, ops *GetTasksOps) (Tasks, Pages, error)
+	AllProjectTasks(ctx context.Context, id string, ops *GetTasksOps) iter.Seq2[Task, error]
+	GetAllProjectTasks(id string, ops *GetTasksOps) (Tasks, error)
+	GetAllProjectTasksContext(ctx context.Context, id string, ops *GetTasksOps) (Tasks, error)
+	GetTaskListTasks(id string, ops *GetTasksOps) (Tasks, Pages, error)
+	GetTaskListTasksContext(ctx context.Context, id string, ops *GetTasksOps) (Tasks, Pages, error)
+	AllTaskListTasks(ctx context.Context, id string, ops *GetTasksOps) iter.Seq2[Task, error]
+	GetAllTaskListTasks(id string, ops *GetTasksOps) (Tasks, error)
+	GetAllTaskListTasksContext(ctx context.Context, id string, ops *GetTasksOps) (Tasks, error)
+	CreateTask(taskListID string, ops *CreateTaskOps) (*CreateTaskResponse, error)
+	CreateTaskContext(ctx context.Context, taskListID string, ops *CreateTaskOps) (*CreateTaskResponse, error)
+	CreateSubTask(taskID string, ops *CreateTaskOps) (*CreateTaskResponse, error)
+	CreateSubTaskContext(ctx context.Context, taskID string, ops *CreateTaskOps) (*CreateTaskResponse, error)
+	UpdateTask(id string, ops *UpdateTaskOps) (*StatusResponse, error)
+	UpdateTaskContext(ctx context.Context, id string, ops *UpdateTaskOps) (*StatusResponse, error)
+	MoveTask(id, taskListID string) (*StatusResponse, error)
+	MoveTaskContext(ctx context.Context, id, taskListID string) (*StatusResponse, error)
+	CompleteTask(id string) (*StatusResponse, error)
+	CompleteTaskContext(ctx context.Context, id string) (*StatusResponse, error)
+	UncompleteTask(id string) (*StatusResponse, error)
+	UncompleteTaskContext(ctx context.Context, id string) (*StatusResponse, error)
+	DeleteTask(id string) (*StatusResponse, error)
+	DeleteTaskContext(ctx context.Context, id string) (*StatusResponse, error)
+	GetProjectTaskLists(id string, ops *GetProjectTaskListsOps) (TaskLists, Pages, error)
+	GetProjectTaskListsContext(ctx context.Context, id string, ops *GetProjectTaskListsOps) (TaskLists, Pages, error)
+	AllProjectTaskLists(ctx context.Context, id string, ops *GetProjectTaskListsOps) iter.Seq2[TaskList, error]
+	GetAllProjectTaskLists(id string, ops *GetProjectTaskListsOps) (TaskLists, error)
+	GetAllProjectTaskListsContext(ctx context.Context, id string, ops *GetProjectTaskListsOps) (TaskLists, error)
+	GetTaskList(id string) (TaskList, error)
+	GetTaskListContext(ctx context.Context, id string) (TaskList, error)
+	CreateTaskList(projectID string, ops *CreateTaskListOps) (*CreateTaskListResponse, error)
+	CreateTaskListContext(ctx context.Context, projectID string, ops *CreateTaskListOps) (*CreateTaskListResponse, error)
+	CreateTaskListFromTemplate(projectID string, ops *CreateTaskListFromTemplateOps) (*CreateTaskListResponse, error)
+	CreateTaskListFromTemplateContext(ctx context.Context, projectID string, ops *CreateTaskListFromTemplateOps) (*CreateTaskListResponse, error)
+	UpdateTaskList(id string, ops *UpdateTaskListOps) (*StatusResponse, error)
+	UpdateTaskListContext(ctx context.Context, id string, ops *UpdateTaskListOps) (*StatusResponse, error)
+	DeleteTaskList(id string) (*StatusResponse, error)
+	DeleteTaskListContext(ctx context.Context, id string) (*StatusResponse, error)
+	CompleteTaskList(id string) (*StatusResponse, error)
+	CompleteTaskListContext(ctx context.Context, id string) (*StatusResponse, error)
+	ReorderTaskLists(projectID string, taskListIDs []string) (*StatusResponse, error)
+	ReorderTaskListsContext(ctx context.Context, projectID string, taskListIDs []string) (*StatusResponse, error)
+}
+
+// MilestonesService describes the milestone calls of the TeamWork API.
+type MilestonesService interface {
+	GetMilestones(ops *GetMilestonesOps) (Milestones, Pages, error)
+	GetMilestonesContext(ctx context.Context, ops *GetMilestonesOps) (Milestones, Pages, error)
+	AllMilestones(ctx context.Context, ops *GetMilestonesOps) iter.Seq2[Milestone, error]
+	GetAllMilestones(ops *GetMilestonesOps) (Milestones, error)
+	GetAllMilestonesContext(ctx context.Context, ops *GetMilestonesOps) (Milestones, error)
+	GetProjectMilestones(id string, ops *GetMilestonesOps) (Milestones, Pages, error)
+	GetProjectMilestonesContext(ctx context.Context, id string, ops *GetMilestonesOps) (Milestones, Pages, error)
+	AllProjectMilestones(ctx context.Context, id string, ops *GetMilestonesOps) iter.Seq2[Milestone, error]
+	GetAllProjectMilestones(id string, ops *GetMilestonesOps) (Milestones, error)
+	GetAllProjectMilestonesContext(ctx context.Context, id string, ops *GetMilestonesOps) (Milestones, error)
+	GetMilestone(id string) (Milestone, error)
+	GetMilestoneContext(ctx context.Context, id string) (Milestone, error)
+	CreateMilestone(projectID string, ops *CreateMilestoneOps) (*CreateMilestoneResponse, error)
+	CreateMilestoneContext(ctx context.Context, projectID string, ops *CreateMilestoneOps) (*CreateMilestoneResponse, error)
+	UpdateMilestone(id string, ops *UpdateMilestoneOps) (*StatusResponse, error)
+	UpdateMilestoneContext(ctx context.Context, id string, ops *UpdateMilestoneOps) (*StatusResponse, error)
+	CompleteMilestone(id string) (*StatusResponse, error)
+	CompleteMilestoneContext(ctx context.Context, id string) (*StatusResponse, error)
+	UncompleteMilestone(id string) (*StatusResponse, error)
+	UncompleteMilestoneContext(ctx context.Context, id string) (*StatusResponse, error)
+	DeleteMilestone(id string) (*StatusResponse, error)
+	DeleteMilestoneContext(ctx context.Context, id string) (*StatusResponse, error)
+}
+
+// CommentsService describes the comment calls of the TeamWork API.
+type CommentsService interface {
+	GetComments(resourceType, id string, ops *GetCommentsOps) (Comments, Pages, error)
+	GetCommentsContext(ctx context.Context, resourceType, id string, ops *GetCommentsOps) (Comments, Pages, error)
+	AllComments(ctx context.Context, resourceType, id string, ops *GetCommentsOps) iter.Seq2[Comment, error]
+	GetAllComments(resourceType, id string, ops *GetCommentsOps) (Comments, error)
+	GetAllCommentsContext(ctx context.Context, resourceType, id string, ops *GetCommentsOps) (Comments, error)
+	CreateComment(resourceType, id string, ops *CreateCommentOps) (*CreateCommentResponse, error)
+	CreateCommentContext(ctx context.Context, resourceType, id string, ops *CreateCommentOps) (*CreateCommentResponse, error)
+	UpdateComment(id string, ops *UpdateCommentOps) (*StatusResponse, error)
+	UpdateCommentContext(ctx context.Context, id string, ops *UpdateCommentOps) (*StatusResponse, error)
+	DeleteComment(id string) (*StatusResponse, error)
+	DeleteCommentContext(ctx context.Context, id string) (*StatusResponse, error)
+	MarkCommentRead(id string) (*StatusResponse, error)
+	MarkCommentReadContext(ctx context.Context, id string) (*StatusResponse, error)
+	UploadPendingFile(filename string, file io.Reader) (PendingFile, error)
+	UploadPendingFileContext(ctx context.Context, filename string, file io.Reader) (PendingFile, error)
+}
+
+// TimeService describes the time tracking calls of the TeamWork API.
+type TimeService interface {
+	GetTimeEntries(ops *GetTimeEntriesOps) (TimeEntries, Pages, error)
+	GetTimeEntriesContext(ctx context.Context, ops *GetTimeEntriesOps) (TimeEntries, Pages, error)
+	AllTimeEntries(ctx context.Context, ops *GetTimeEntriesOps) iter.Seq2[TimeEntry, error]
+	GetAllTimeEntries(ops *GetTimeEntriesOps) (TimeEntries, error)
+	GetAllTimeEntriesContext(ctx context.Context, ops *GetTimeEntriesOps) (TimeEntries, error)
+	GetProjectTimeEntries(id string, ops *GetTimeEntriesOps) (TimeEntries, Pages, error)
+	GetProjectTimeEntriesContext(ctx context.Context, id string, ops *GetTimeEntriesOps) (TimeEntries, Pages, error)
+	AllProjectTimeEntries(ctx context.Context, id string, ops *GetTimeEntriesOps) iter.Seq2[TimeEntry, error]
+	GetAllProjectTimeEntries(id string, ops *GetTimeEntriesOps) (TimeEntries, error)
+	GetAllProjectTimeEntriesContext(ctx context.Context, id string, ops *GetTimeEntriesOps) (TimeEntries, error)
+	CreateTimeEntryForProject(projectID string, ops *CreateTimeEntryOps) (*CreateTimeEntryResponse, error)
+	CreateTimeEntryForProjectContext(ctx context.Context, projectID string, ops *CreateTimeEntryOps) (*CreateTimeEntryResponse, error)
+	CreateTimeEntryForTask(taskID string, ops *CreateTimeEntryOps) (*CreateTimeEntryResponse, error)
+	CreateTimeEntryForTaskContext(ctx context.Context, taskID string, ops *CreateTimeEntryOps) (*CreateTimeEntryResponse, error)
+	GetTimeEntry(id string) (TimeEntry, error)
+	GetTimeEntryContext(ctx context.Context, id string) (TimeEntry, error)
+	UpdateTimeEntry(id string, ops *UpdateTimeEntryOps) (*StatusResponse, error)
+	UpdateTimeEntryContext(ctx context.Context, id string, ops *UpdateTimeEntryOps) (*StatusResponse, error)
+	DeleteTimeEntry(id string) (*DeleteTimeEntryResponse, error)
+	DeleteTimeEntryContext(ctx context.Context, id string) (*DeleteTimeEntryResponse, error)
+	GetTaskTimeEntries(id string, ops *GetTimeEntriesOps) (TimeEntries, Pages, error)
+	GetTaskTimeEntriesContext(ctx context.Context, id string, ops *GetTimeEntriesOps) (TimeEntries, Pages, error)
+	AllTaskTimeEntries(ctx context.Context, id string, ops *GetTimeEntriesOps) iter.Seq2[TimeEntry, error]
+	GetAllTaskTimeEntries(id string, ops *GetTimeEntriesOps) (TimeEntries, error)
+	GetAllTaskTimeEntriesContext(ctx context.Context, id string, ops *GetTimeEntriesOps) (TimeEntries, error)
+	GetTotalTime(ops *GetTotalTimeOps) (TotalTime, error)
+	GetTotalTimeContext(ctx context.Context, ops *GetTotalTimeOps) (TotalTime, error)
+	GetProjectTotalTime(id string, ops *GetTotalTimeOps) (ProjectTotalTimes, error)
+	GetProjectTotalTimeContext(ctx context.Context, id string, ops *GetTotalTimeOps) (ProjectTotalTimes, error)
+	GetTaskListTotalTime(id string, ops *GetTotalTimeOps) (ProjectTaskListTotalTimes, error)
+	GetTaskListTotalTimeContext(ctx context.Context, id string, ops *GetTotalTimeOps) (ProjectTaskListTotalTimes, error)
+	GetTaskTotalTime(id string, ops *GetTotalTimeOps) (ProjectTaskTotalTimes, error)
+	GetTaskTotalTimeContext(ctx context.Context, id string, ops *GetTotalTimeOps) (ProjectTaskTotalTimes, error)
+}
+
+// Client is all the calls of the TeamWork API.
+// It is implemented by *Connection.
+type Client interface {
+	ProjectsService
+	PeopleService
+	CompaniesService
+	TasksService
+	MilestonesService
+	CommentsService
+	TimeService
+
+	// RateLimit returns the rate limit TeamWork reported in the most recent response.
+	RateLimit() RateLimit
+}
+
+var _ Client = (*Connection)(nil)
@@ -0,0 +1,297 @@
+package teamwork
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"mime/multipart"
+)
+
+// Comments is a list of Comment
+type Comments []Comment
+
+// The Comment structure.
+type Comment struct {
+	Attachments      []CommentAttachment `json:"attachments"`
+	AttachmentsCount FlexInt             `json:"attachments-count"`
+	AuthorAvatarURL  string              `json:"author-avatar-url"`
+	AuthorFirstname  string              `json:"author-firstname"`
+	AuthorID         ID                  `json:"author-id"`
+	AuthorLastname   string              `json:"author-lastname"`
+	Body             string              `json:"body"`
+	CommentLink      string              `json:"comment-link"`
+	CommentNo        FlexInt             `json:"commentNo"`
+	CompanyID        ID                  `json:"company-id"`
+	CompanyName      string              `json:"company-name"`
+	ContentType      string              `json:"content-type"`
+	DateTime         Time                `json:"datetime"`
+	EmailedFrom      string              `json:"emailed-from"`
+	HasRead          FlexBool            `json:"has-read"`
+	HTMLBody         string              `json:"html-body"`
+	ID               ID                  `json:"id"`
+	ItemName         string              `json:"item-name"`
+	LastChangedOn    Time                `json:"last-changed-on"`
+	Notified         string              `json:"notified"`
+	Private          FlexBool            `json:"private"`
+	ProjectID        ID                  `json:"project-id"`
+	ProjectName      string              `json:"project-name"`
+}
+
+// CommentAttachment is a file attached to a comment.
+type CommentAttachment struct {
+	Filename string  `json:"filename"`
+	ID       ID      `json:"id"`
+	Size     FlexInt `json:"size"`
+	URL      string  `json:"url"`
+}
+
+// GetCommentsOps is used to generate the query params for the
+// GetComments API call.
+type GetCommentsOps struct {
+	// A page of results.  Access additional pages.  (eg: 2, etc...)
+	Page *int `param:"page"`
+	// The number of comments on a page.
+	PageSize *int `param:"pageSize"`
+}
+
+// GetComments gets the comments on a resource according to the specified
+// GetCommentsOps which are passed in.  The resourceType is the kind of item
+// commented on and id is its ID.
+// Valid resourceType: "tasks", "milestones", "files", "notebooks", "links"
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/comments/get-resource-resource-id-comments-json
+func (conn *Connection) GetComments(resourceType, id string, ops *GetCommentsOps) (Comments, Pages, error) {
+	return conn.GetCommentsContext(context.Background(), resourceType, id, ops)
+}
+
+// GetCommentsContext is like GetComments but uses ctx for the request.
+func (conn *Connection) GetCommentsContext(ctx context.Context, resourceType, id string, ops *GetCommentsOps) (Comments, Pages, error) {
+	comments := make(Comments, 0)
+	pages := &Pages{}
+	params := buildParams(ops)
+	method := "GET"
+	url := fmt.Sprintf("%s%s/%s/comments.json%s", conn.baseURL, resourceType, id, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
+	if err != nil {
+		return comments, *pages, err
+	}
+	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return comments, *pages, err
+	}
+
+	err = json.NewDecoder(reader).Decode(&struct {
+		*Comments `json:"comments"`
+	}{&comments})
+	if err != nil {
+		return comments, *pages, err
+	}
+
+	return comments, *pages, nil
+}
+
+// AllComments returns an iterator over all the comments on a resource according to the
+// specified GetCommentsOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllComments(ctx context.Context, resourceType, id string, ops *GetCommentsOps) iter.Seq2[Comment, error] {
+	return conn.commentsPager(resourceType, id, ops).all(ctx)
+}
+
+// commentsPager fetches the pages for AllComments and GetAllComments.
+func (conn *Connection) commentsPager(resourceType, id string, ops *GetCommentsOps) pager[Comment] {
+	pageOps := GetCommentsOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Comment]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Comment, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetCommentsContext(ctx, resourceType, id, &ops)
+		},
+	}
+}
+
+// GetAllComments gets all the comments on a resource according to the specified
+// GetCommentsOps, walking every page.
+func (conn *Connection) GetAllComments(resourceType, id string, ops *GetCommentsOps) (Comments, error) {
+	return conn.GetAllCommentsContext(context.Background(), resourceType, id, ops)
+}
+
+// GetAllCommentsContext is like GetAllComments but uses ctx for the requests.
+func (conn *Connection) GetAllCommentsContext(ctx context.Context, resourceType, id string, ops *GetCommentsOps) (Comments, error) {
+	return conn.commentsPager(resourceType, id, ops).collect(ctx, conn.pageWorkers)
+}
+
+// CreateCommentOps is used to generate the body for the
+// CreateComment API call.
+type CreateCommentOps struct {
+	// The text of the comment.
+	Body string `json:"body"`
+	// Valid Input: "TEXT", "HTML"
+	// Default: "TEXT"
+	ContentType string `json:"content-type,omitempty"`
+	// Who to email about the comment: "all" for everyone on the project,
+	// or a comma separated list of person IDs.
+	Notify string `json:"notify,omitempty"`
+	// Valid Input: true, false
+	IsPrivate *bool `json:"isprivate,omitempty"`
+	// A comma separated list of the refs of the files to attach.  Upload
+	// each file with UploadPendingFile first to get its ref.
+	PendingFileAttachments string `json:"pendingFileAttachments,omitempty"`
+}
+
+// CreateCommentResponse captures the response returned from a create comment action
+type CreateCommentResponse struct {
+	ID     ID     `json:"commentId"`
+	Status string `json:"STATUS"`
+}
+
+// CreateComment adds a comment to a resource according to the specified
+// CreateCommentOps which are passed in.  The resourceType is the same as
+// for GetComments.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/comments/post-resource-resource-id-comments-json
+func (conn *Connection) CreateComment(resourceType, id string, ops *CreateCommentOps) (*CreateCommentResponse, error) {
+	return conn.CreateCommentContext(context.Background(), resourceType, id, ops)
+}
+
+// CreateCommentContext is like CreateComment but uses ctx for the request.
+func (conn *Connection) CreateCommentContext(ctx context.Context, resourceType, id string, ops *CreateCommentOps) (*CreateCommentResponse, error) {
+	createResponse := &CreateCommentResponse{}
+	url := fmt.Sprintf("%s%s/%s/comments.json", conn.baseURL, resourceType, id)
+	err := conn.sendJSON(ctx, "POST", url, struct {
+		Comment *CreateCommentOps `json:"comment"`
+	}{ops}, createResponse)
+	if err != nil {
+		return nil, err
+	}
+	return createResponse, nil
+}
+
+// UpdateCommentOps is used to generate the body for the
+// UpdateComment API call.  Only the fields which are set are updated.
+type UpdateCommentOps struct {
+	// The text of the comment.
+	Body string `json:"body,omitempty"`
+	// Valid Input: "TEXT", "HTML"
+	ContentType string `json:"content-type,omitempty"`
+	// Who to email about the change: "all" for everyone on the project,
+	// or a comma separated list of person IDs.
+	Notify string `json:"notify,omitempty"`
+	// Valid Input: true, false
+	IsPrivate *bool `json:"isprivate,omitempty"`
+	// A comma separated list of the refs of the files to attach.  Upload
+	// each file with UploadPendingFile first to get its ref.
+	PendingFileAttachments string `json:"pendingFileAttachments,omitempty"`
+}
+
+// UpdateComment updates a comment according to the specified
+// UpdateCommentOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/comments/put-comments-id-json
+func (conn *Connection) UpdateComment(id string, ops *UpdateCommentOps) (*StatusResponse, error) {
+	return conn.UpdateCommentContext(context.Background(), id, ops)
+}
+
+// UpdateCommentContext is like UpdateComment but uses ctx for the request.
+func (conn *Connection) UpdateCommentContext(ctx context.Context, id string, ops *UpdateCommentOps) (*StatusResponse, error) {
+	updateResponse := &StatusResponse{}
+	url := fmt.Sprintf("%scomments/%s.json", conn.baseURL, id)
+	err := conn.sendJSON(ctx, "PUT", url, struct {
+		Comment *UpdateCommentOps `json:"comment"`
+	}{ops}, updateResponse)
+	if err != nil {
+		return nil, err
+	}
+	return updateResponse, nil
+}
+
+// DeleteComment deletes a comment.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/comments/delete-comments-id-json
+func (conn *Connection) DeleteComment(id string) (*StatusResponse, error) {
+	return conn.DeleteCommentContext(context.Background(), id)
+}
+
+// DeleteCommentContext is like DeleteComment but uses ctx for the request.
+func (conn *Connection) DeleteCommentContext(ctx context.Context, id string) (*StatusResponse, error) {
+	deleteResponse := &StatusResponse{}
+	url := fmt.Sprintf("%scomments/%s.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "DELETE", url, nil, deleteResponse); err != nil {
+		return nil, err
+	}
+	return deleteResponse, nil
+}
+
+// MarkCommentRead marks a comment as read by the person the API token
+// belongs to.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/comments/put-comments-id-markread-json
+func (conn *Connection) MarkCommentRead(id string) (*StatusResponse, error) {
+	return conn.MarkCommentReadContext(context.Background(), id)
+}
+
+// MarkCommentReadContext is like MarkCommentRead but uses ctx for the request.
+func (conn *Connection) MarkCommentReadContext(ctx context.Context, id string) (*StatusResponse, error) {
+	readResponse := &StatusResponse{}
+	url := fmt.Sprintf("%scomments/%s/markread.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "PUT", url, nil, readResponse); err != nil {
+		return nil, err
+	}
+	return readResponse, nil
+}
+
+// PendingFile is a file uploaded to TeamWork which is not attached to
+// anything yet.
+type PendingFile struct {
+	// The ref to pass in PendingFileAttachments to attach the file.
+	Ref string `json:"ref"`
+}
+
+// UploadPendingFile uploads the contents of file as filename, ready to be
+// attached to a comment through its PendingFileAttachments.  A pending file
+// which is not attached to anything is deleted by TeamWork after a while.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/files/post-pendingfiles-json
+func (conn *Connection) UploadPendingFile(filename string, file io.Reader) (PendingFile, error) {
+	return conn.UploadPendingFileContext(context.Background(), filename, file)
+}
+
+// UploadPendingFileContext is like UploadPendingFile but uses ctx for the request.
+func (conn *Connection) UploadPendingFileContext(ctx context.Context, filename string, file io.Reader) (PendingFile, error) {
+	pendingFile := &PendingFile{}
+	// the form is streamed to TeamWork as it is written rather than held in memory
+	body, formWriter := io.Pipe()
+	defer body.Close()
+	form := multipart.NewWriter(formWriter)
+	go func() {
+		part, err := form.CreateFormFile("file", filename)
+		if err == nil {
+			_, err = io.Copy(part, file)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		formWriter.CloseWithError(err)
+	}()
+
+	url := fmt.Sprintf("%spendingfiles.json", conn.baseURL)
+	reader, _, err := conn.requestContent(ctx, "POST", url, form.FormDataContentType(), body)
+	if err != nil {
+		return *pendingFile, err
+	}
+	defer reader.Close()
+
+	err = json.NewDecoder(reader).Decode(&struct {
+		*PendingFile `json:"pendingFile"`
+	}{pendingFile})
+	if err != nil {
+		return *pendingFile, err
+	}
+
+	return *pendingFile, nil
+}
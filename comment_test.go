@@ -0,0 +1,179 @@
+package teamwork_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/swill/teamwork"
+)
+
+func ExampleConnection_CreateComment() {
+	// a stand-in for TeamWork which takes the upload and the comment
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/authenticate.json":
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+		case "/pendingfiles.json":
+			_, header, err := r.FormFile("file")
+			if err != nil {
+				http.Error(w, `{"MESSAGE": "No file", "STATUS": "Error"}`, http.StatusBadRequest)
+				return
+			}
+			fmt.Println("Uploaded:", header.Filename)
+			fmt.Fprint(w, `{"pendingFile": {"ref": "tf_5f1c2d"}}`)
+		case "/tasks/10/comments.json":
+			body := struct {
+				Comment teamwork.CreateCommentOps `json:"comment"`
+			}{}
+			json.NewDecoder(r.Body).Decode(&body)
+			fmt.Println("Comment:", body.Comment.Body)
+			fmt.Println("Attached:", body.Comment.PendingFileAttachments)
+			fmt.Fprint(w, `{"commentId": "1234", "STATUS": "OK"}`)
+		}
+	}))
+	defer server.Close()
+
+	// setup the teamwork connection
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken")
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// upload the build log first, to get the ref to attach it by
+	pendingFile, err := conn.UploadPendingFile("build-42.log", strings.NewReader("FAIL: TestBuild"))
+	if err != nil {
+		fmt.Printf("Error uploading the log: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// post the build results, notifying two people and attaching the log
+	createResponse, err := conn.CreateComment("tasks", "10", &teamwork.CreateCommentOps{
+		Body:                   "Build #42 failed",
+		Notify:                 "2,3",
+		PendingFileAttachments: pendingFile.Ref,
+	})
+	if err != nil {
+		fmt.Printf("Error creating Comment: %s", err.Error())
+		os.Exit(1)
+	}
+	fmt.Println("Comment ID:", createResponse.ID)
+	// Output:
+	// Uploaded: build-42.log
+	// Comment: Build #42 failed
+	// Attached: tf_5f1c2d
+	// Comment ID: 1234
+}
+
+func TestUploadPendingFile(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		if r.Method != "POST" || r.URL.Path != "/pendingfiles.json" {
+			t.Errorf("call = %s %s, want POST /pendingfiles.json", r.Method, r.URL.Path)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("FormFile(file) error = %v", err)
+			http.Error(w, `{"MESSAGE": "No file", "STATUS": "Error"}`, http.StatusBadRequest)
+			return
+		}
+		data, _ := io.ReadAll(file)
+		if header.Filename != "build-42.log" || string(data) != "FAIL: TestBuild" {
+			t.Errorf("uploaded %s = %q, want build-42.log = FAIL: TestBuild", header.Filename, data)
+		}
+		fmt.Fprint(w, `{"pendingFile": {"ref": "tf_5f1c2d"}}`)
+	}))
+	defer server.Close()
+
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken")
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	pendingFile, err := conn.UploadPendingFile("build-42.log", strings.NewReader("FAIL: TestBuild"))
+	if err != nil || pendingFile.Ref != "tf_5f1c2d" {
+		t.Errorf("UploadPendingFile() = %+v, %v, want tf_5f1c2d", pendingFile, err)
+	}
+}
+
+func TestUploadPendingFileReadError(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		if _, _, err := r.FormFile("file"); err == nil {
+			t.Errorf("FormFile(file) read a whole file, want the upload cut short")
+		}
+		http.Error(w, `{"MESSAGE": "No file", "STATUS": "Error"}`, http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken")
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	file := iotest.ErrReader(errors.New("disk gone"))
+	if _, err := conn.UploadPendingFile("build-42.log", file); err == nil {
+		t.Error("UploadPendingFile() error = nil, want the read error")
+	}
+}
+
+func TestComments(t *testing.T) {
+	conn := connectTo(t,
+		apiCall{
+			method:   "POST",
+			path:     "/tasks/10/comments.json",
+			body:     `{"comment": {"body": "Build #42 failed", "content-type": "TEXT", "notify": "2,3", "isprivate": true, "pendingFileAttachments": "tf_5f1c2d"}}`,
+			response: `{"commentId": "1234", "STATUS": "OK"}`,
+		},
+		apiCall{method: "PUT", path: "/comments/1234.json", body: `{"comment": {"body": "Build #42 passed"}}`},
+		apiCall{method: "PUT", path: "/comments/1234/markread.json"},
+		apiCall{method: "DELETE", path: "/comments/1234.json"},
+	)
+
+	private := true
+	createResponse, err := conn.CreateComment("tasks", "10", &teamwork.CreateCommentOps{
+		Body:                   "Build #42 failed",
+		ContentType:            "TEXT",
+		Notify:                 "2,3",
+		IsPrivate:              &private,
+		PendingFileAttachments: "tf_5f1c2d",
+	})
+	if err != nil || createResponse.ID != "1234" {
+		t.Fatalf("CreateComment() = %+v, %v, want 1234", createResponse, err)
+	}
+	if _, err := conn.UpdateComment("1234", &teamwork.UpdateCommentOps{Body: "Build #42 passed"}); err != nil {
+		t.Errorf("UpdateComment() error = %v", err)
+	}
+	if _, err := conn.MarkCommentRead("1234"); err != nil {
+		t.Errorf("MarkCommentRead() error = %v", err)
+	}
+	if _, err := conn.DeleteComment("1234"); err != nil {
+		t.Errorf("DeleteComment() error = %v", err)
+	}
+}
+
+func TestGetAllComments(t *testing.T) {
+	conn := connectToPages(t, "/tasks/4486838/comments.json", "comments", 5)
+	comments, err := conn.GetAllComments("tasks", "4486838", &teamwork.GetCommentsOps{})
+	if err != nil {
+		t.Fatalf("GetAllComments() error = %v", err)
+	}
+	if got := pageIDs(comments, func(c teamwork.Comment) teamwork.ID { return c.ID }); got != "1,2,3,4,5" {
+		t.Errorf("GetAllComments() IDs = %s, want 1,2,3,4,5", got)
+	}
+}
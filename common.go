@@ -1,16 +1,104 @@
 package teamwork
 
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
 // Pages provides a way to page requests
 // The X-Page(s) headers that are returned with queries.
 // The struct is populated by the headers when returning
 // lists of data from TeamWork.  Use thic concept to
 // set a struct from the response headers of the API.
 // You only have to specify the `header:"Header-Name"`
-// and then use `get_headers(headers, &struct)` to
+// and then use `getHeaders(headers, &struct)` to
 // populate.
-// Currently supports: Int and String
+// Currently supports: String, Bool, Int, Uint, Float,
+// time.Time, time.Duration and http.Header
 type Pages struct {
 	Page    int `header:"X-Page"`
 	Pages   int `header:"X-Pages"`
 	Records int `header:"X-Records"`
+	// The rate limit reported with the response.
+	RateLimitLimit     int `header:"X-RateLimit-Limit"`
+	RateLimitRemaining int `header:"X-RateLimit-Remaining"`
+	RateLimitReset     int `header:"X-RateLimit-Reset"`
+	// The ID TeamWork gave the request, useful when reporting issues.
+	RequestID string `header:"X-Request-Id"`
+}
+
+// getPages sets pages from the headers of a list response.  Only a bad
+// X-Page, X-Pages or X-Records header is an error, since the pages cannot
+// be walked without them.  A bad optional header, eg: X-RateLimit-Limit, is
+// logged and left at its zero value so the items decoded are still returned.
+func (conn *Connection) getPages(headers http.Header, pages *Pages) error {
+	err := getHeaders(headers, pages)
+	if err == nil {
+		return nil
+	}
+	paging := struct {
+		Page    int `header:"X-Page"`
+		Pages   int `header:"X-Pages"`
+		Records int `header:"X-Records"`
+	}{}
+	if err := getHeaders(headers, &paging); err != nil {
+		return err
+	}
+	conn.logger.Warn("teamwork: ignoring a bad header", "error", err)
+	return nil
+}
+
+// ResponseMeta is the metadata of a response from TeamWork, for the calls
+// which do not return Pages or when the raw headers are needed.  Pass one
+// to the *Context calls with WithResponseMeta.
+type ResponseMeta struct {
+	StatusCode int
+	// The rate limit reported with the response.
+	RateLimitLimit     int `header:"X-RateLimit-Limit"`
+	RateLimitRemaining int `header:"X-RateLimit-Remaining"`
+	RateLimitReset     int `header:"X-RateLimit-Reset"`
+	// The ID TeamWork gave the request, useful when reporting issues.
+	RequestID string `header:"X-Request-Id"`
+	// All the headers of the response.
+	Header http.Header `header:"*"`
+}
+
+// responseMetaKey is the context key of the responseMetaSink to set.
+type responseMetaKey struct{}
+
+// responseMetaSink guards the ResponseMeta of WithResponseMeta, which is
+// set by every page fetch of the GetAll calls at once.
+type responseMetaSink struct {
+	mu   sync.Mutex
+	meta *ResponseMeta
+}
+
+// WithResponseMeta returns a copy of ctx which makes the calls using it
+// set meta from their response, including when TeamWork responds with an
+// error.  With several calls, meta holds the last response.  Do not read
+// meta while a call using ctx is running.
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, &responseMetaSink{meta: meta})
+}
+
+// setResponseMeta sets the ResponseMeta of ctx, if any, from resp.
+// Headers which cannot be parsed are left at their zero value.
+func setResponseMeta(ctx context.Context, resp *http.Response) {
+	sink, ok := ctx.Value(responseMetaKey{}).(*responseMetaSink)
+	if !ok || sink.meta == nil {
+		return
+	}
+	meta := ResponseMeta{StatusCode: resp.StatusCode}
+	getHeaders(resp.Header, &meta)
+
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	*sink.meta = meta
+}
+
+// StatusResponse captures the response returned by the calls which only
+// report whether they succeeded.
+type StatusResponse struct {
+	Status string `json:"STATUS"`
 }
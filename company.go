@@ -0,0 +1,349 @@
+package teamwork
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// Companies is a list of Company
+type Companies []Company
+
+// Company holds a company's data
+type Company struct {
+	Accounts       FlexInt  `json:"accounts"`
+	AddressOne     string   `json:"address_one"`
+	AddressTwo     string   `json:"address_two"`
+	CanSeePrivate  FlexBool `json:"can_see_private"`
+	CID            string   `json:"cid"`
+	City           string   `json:"city"`
+	Collaborators  FlexInt  `json:"collaborators"`
+	CompanyNameURL string   `json:"companyNameUrl"`
+	Contacts       FlexInt  `json:"contacts"`
+	Country        string   `json:"country"`
+	CountryCode    string   `json:"countrycode"`
+	CreatedOn      Time     `json:"created-on"`
+	EmailOne       string   `json:"emailOne"`
+	EmailTwo       string   `json:"emailTwo"`
+	EmailThree     string   `json:"emailThree"`
+	Fax            string   `json:"fax"`
+	ID             ID       `json:"id"`
+	Industry       string   `json:"industry"`
+	IndustryID     ID       `json:"industryId"`
+	IsOwner        FlexBool `json:"isowner"`
+	LastChangedOn  Time     `json:"last-changed-on"`
+	LogoURL        string   `json:"logo-url"`
+	Name           string   `json:"name"`
+	Phone          string   `json:"phone"`
+	PrivateNotes   string   `json:"private-notes"`
+	ProfileText    string   `json:"profile-text"`
+	State          string   `json:"state"`
+	Tags           []struct {
+		Color string `json:"color"`
+		ID    ID     `json:"id"`
+		Name  string `json:"name"`
+	} `json:"tags"`
+	Website string `json:"website"`
+	Zip     string `json:"zip"`
+}
+
+// CompanyRef is the company an item belongs to, as it is nested in
+// projects and time totals.
+type CompanyRef struct {
+	ID      ID       `json:"id"`
+	IsOwner FlexBool `json:"is-owner"`
+	Name    string   `json:"name"`
+}
+
+// GetCompaniesOps is used to generate the query params for the
+// GetCompanies API call.
+type GetCompaniesOps struct {
+	// A page of results.  Access additional pages.  (eg: 2, etc...)
+	Page *int `param:"page"`
+	// The number of companies on a page.
+	PageSize *int `param:"pageSize"`
+}
+
+// GetCompanies gets all the companies available according to the specified
+// GetCompaniesOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/companies/get-companies-json
+func (conn *Connection) GetCompanies(ops *GetCompaniesOps) (Companies, Pages, error) {
+	return conn.GetCompaniesContext(context.Background(), ops)
+}
+
+// GetCompaniesContext is like GetCompanies but uses ctx for the request.
+func (conn *Connection) GetCompaniesContext(ctx context.Context, ops *GetCompaniesOps) (Companies, Pages, error) {
+	companies := make(Companies, 0)
+	pages := &Pages{}
+	params := buildParams(ops)
+	method := "GET"
+	url := fmt.Sprintf("%scompanies.json%s", conn.baseURL, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
+	if err != nil {
+		return companies, *pages, err
+	}
+	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return companies, *pages, err
+	}
+
+	err = json.NewDecoder(reader).Decode(&struct {
+		*Companies `json:"companies"`
+	}{&companies})
+	if err != nil {
+		return companies, *pages, err
+	}
+
+	return companies, *pages, nil
+}
+
+// AllCompanies returns an iterator over all the companies according to the
+// specified GetCompaniesOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllCompanies(ctx context.Context, ops *GetCompaniesOps) iter.Seq2[Company, error] {
+	return conn.companiesPager(ops).all(ctx)
+}
+
+// companiesPager fetches the pages for AllCompanies and GetAllCompanies.
+func (conn *Connection) companiesPager(ops *GetCompaniesOps) pager[Company] {
+	pageOps := GetCompaniesOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Company]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Company, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetCompaniesContext(ctx, &ops)
+		},
+	}
+}
+
+// GetAllCompanies gets all the companies according to the specified
+// GetCompaniesOps, walking every page.
+func (conn *Connection) GetAllCompanies(ops *GetCompaniesOps) (Companies, error) {
+	return conn.GetAllCompaniesContext(context.Background(), ops)
+}
+
+// GetAllCompaniesContext is like GetAllCompanies but uses ctx for the requests.
+func (conn *Connection) GetAllCompaniesContext(ctx context.Context, ops *GetCompaniesOps) (Companies, error) {
+	return conn.companiesPager(ops).collect(ctx, conn.pageWorkers)
+}
+
+// GetCompany gets a single company based on a company ID.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/companies/get-companies-id-json
+func (conn *Connection) GetCompany(id string) (Company, error) {
+	return conn.GetCompanyContext(context.Background(), id)
+}
+
+// GetCompanyContext is like GetCompany but uses ctx for the request.
+func (conn *Connection) GetCompanyContext(ctx context.Context, id string) (Company, error) {
+	company := &Company{}
+	method := "GET"
+	url := fmt.Sprintf("%scompanies/%s.json", conn.baseURL, id)
+	reader, _, err := conn.request(ctx, method, url, nil)
+	if err != nil {
+		return *company, err
+	}
+	defer reader.Close()
+
+	err = json.NewDecoder(reader).Decode(&struct {
+		*Company `json:"company"`
+	}{company})
+	if err != nil {
+		return *company, err
+	}
+
+	return *company, nil
+}
+
+// GetCompanyProjects gets the projects of a company according to the
+// specified GetProjectsOps and company id passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/projects/get-companies-id-projects-json
+func (conn *Connection) GetCompanyProjects(id string, ops *GetProjectsOps) (Projects, Pages, error) {
+	return conn.GetCompanyProjectsContext(context.Background(), id, ops)
+}
+
+// GetCompanyProjectsContext is like GetCompanyProjects but uses ctx for the request.
+func (conn *Connection) GetCompanyProjectsContext(ctx context.Context, id string, ops *GetProjectsOps) (Projects, Pages, error) {
+	projects := make(Projects, 0)
+	pages := &Pages{}
+	params := buildParams(ops)
+	method := "GET"
+	url := fmt.Sprintf("%scompanies/%s/projects.json%s", conn.baseURL, id, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
+	if err != nil {
+		return projects, *pages, err
+	}
+	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return projects, *pages, err
+	}
+
+	err = json.NewDecoder(reader).Decode(&struct {
+		*Projects `json:"projects"`
+	}{&projects})
+	if err != nil {
+		return projects, *pages, err
+	}
+
+	return projects, *pages, nil
+}
+
+// AllCompanyProjects returns an iterator over all the company projects according to the
+// specified GetProjectsOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllCompanyProjects(ctx context.Context, id string, ops *GetProjectsOps) iter.Seq2[Project, error] {
+	return conn.companyProjectsPager(id, ops).all(ctx)
+}
+
+// companyProjectsPager fetches the pages for AllCompanyProjects and GetAllCompanyProjects.
+func (conn *Connection) companyProjectsPager(id string, ops *GetProjectsOps) pager[Project] {
+	pageOps := GetProjectsOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Project]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Project, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetCompanyProjectsContext(ctx, id, &ops)
+		},
+	}
+}
+
+// GetAllCompanyProjects gets all the company projects according to the specified
+// GetProjectsOps, walking every page.
+func (conn *Connection) GetAllCompanyProjects(id string, ops *GetProjectsOps) (Projects, error) {
+	return conn.GetAllCompanyProjectsContext(context.Background(), id, ops)
+}
+
+// GetAllCompanyProjectsContext is like GetAllCompanyProjects but uses ctx for the requests.
+func (conn *Connection) GetAllCompanyProjectsContext(ctx context.Context, id string, ops *GetProjectsOps) (Projects, error) {
+	return conn.companyProjectsPager(id, ops).collect(ctx, conn.pageWorkers)
+}
+
+// CreateCompanyOps is used to generate the body for the
+// CreateCompany API call.
+type CreateCompanyOps struct {
+	// The name of the company.
+	Name string `json:"name"`
+	// Address details.
+	AddressOne  string `json:"address_one,omitempty"`
+	AddressTwo  string `json:"address_two,omitempty"`
+	City        string `json:"city,omitempty"`
+	State       string `json:"state,omitempty"`
+	Zip         string `json:"zip,omitempty"`
+	CountryCode string `json:"countrycode,omitempty"`
+	// Contact details.
+	Phone      string `json:"phone,omitempty"`
+	Fax        string `json:"fax,omitempty"`
+	Website    string `json:"website,omitempty"`
+	EmailOne   string `json:"emailOne,omitempty"`
+	EmailTwo   string `json:"emailTwo,omitempty"`
+	EmailThree string `json:"emailThree,omitempty"`
+	// The ID of the industry of the company.
+	IndustryID   string `json:"industryCatId,omitempty"`
+	PrivateNotes string `json:"privateNotes,omitempty"`
+	ProfileText  string `json:"profile,omitempty"`
+	// A comma separated list of tag IDs.
+	TagIDs string `json:"tagIds,omitempty"`
+}
+
+// UpdateCompanyOps is used to generate the body for the
+// UpdateCompany API call.  Only the fields which are set are updated.
+type UpdateCompanyOps struct {
+	// The name of the company.
+	Name string `json:"name,omitempty"`
+	// Address details.
+	AddressOne  string `json:"address_one,omitempty"`
+	AddressTwo  string `json:"address_two,omitempty"`
+	City        string `json:"city,omitempty"`
+	State       string `json:"state,omitempty"`
+	Zip         string `json:"zip,omitempty"`
+	CountryCode string `json:"countrycode,omitempty"`
+	// Contact details.
+	Phone      string `json:"phone,omitempty"`
+	Fax        string `json:"fax,omitempty"`
+	Website    string `json:"website,omitempty"`
+	EmailOne   string `json:"emailOne,omitempty"`
+	EmailTwo   string `json:"emailTwo,omitempty"`
+	EmailThree string `json:"emailThree,omitempty"`
+	// The ID of the industry of the company.
+	IndustryID   string `json:"industryCatId,omitempty"`
+	PrivateNotes string `json:"privateNotes,omitempty"`
+	ProfileText  string `json:"profile,omitempty"`
+	// A comma separated list of tag IDs.
+	TagIDs string `json:"tagIds,omitempty"`
+}
+
+// CreateCompanyResponse captures the response returned from a create company action
+type CreateCompanyResponse struct {
+	ID     ID     `json:"id"`
+	Status string `json:"STATUS"`
+}
+
+// CreateCompany adds a company according to the specified
+// CreateCompanyOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/companies/post-companies-json
+func (conn *Connection) CreateCompany(ops *CreateCompanyOps) (*CreateCompanyResponse, error) {
+	return conn.CreateCompanyContext(context.Background(), ops)
+}
+
+// CreateCompanyContext is like CreateCompany but uses ctx for the request.
+func (conn *Connection) CreateCompanyContext(ctx context.Context, ops *CreateCompanyOps) (*CreateCompanyResponse, error) {
+	createResponse := &CreateCompanyResponse{}
+	url := fmt.Sprintf("%scompanies.json", conn.baseURL)
+	err := conn.sendJSON(ctx, "POST", url, struct {
+		Company *CreateCompanyOps `json:"company"`
+	}{ops}, createResponse)
+	if err != nil {
+		return nil, err
+	}
+	return createResponse, nil
+}
+
+// UpdateCompany updates a company according to the specified
+// UpdateCompanyOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/companies/put-companies-id-json
+func (conn *Connection) UpdateCompany(id string, ops *UpdateCompanyOps) (*StatusResponse, error) {
+	return conn.UpdateCompanyContext(context.Background(), id, ops)
+}
+
+// UpdateCompanyContext is like UpdateCompany but uses ctx for the request.
+func (conn *Connection) UpdateCompanyContext(ctx context.Context, id string, ops *UpdateCompanyOps) (*StatusResponse, error) {
+	updateResponse := &StatusResponse{}
+	url := fmt.Sprintf("%scompanies/%s.json", conn.baseURL, id)
+	err := conn.sendJSON(ctx, "PUT", url, struct {
+		Company *UpdateCompanyOps `json:"company"`
+	}{ops}, updateResponse)
+	if err != nil {
+		return nil, err
+	}
+	return updateResponse, nil
+}
+
+// DeleteCompany deletes a company.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/companies/delete-companies-id-json
+func (conn *Connection) DeleteCompany(id string) (*StatusResponse, error) {
+	return conn.DeleteCompanyContext(context.Background(), id)
+}
+
+// DeleteCompanyContext is like DeleteCompany but uses ctx for the request.
+func (conn *Connection) DeleteCompanyContext(ctx context.Context, id string) (*StatusResponse, error) {
+	deleteResponse := &StatusResponse{}
+	url := fmt.Sprintf("%scompanies/%s.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "DELETE", url, nil, deleteResponse); err != nil {
+		return nil, err
+	}
+	return deleteResponse, nil
+}
@@ -0,0 +1,116 @@
+package teamwork_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/swill/teamwork"
+	"github.com/swill/teamwork/teamworktest"
+)
+
+func ExampleConnection_CreateCompany() {
+	// a fake TeamWork to create the company in
+	server := teamworktest.NewServer()
+	defer server.Close()
+
+	// setup the teamwork connection
+	conn, err := server.Connect()
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// create a company and a project for it
+	createResponse, err := conn.CreateCompany(&teamwork.CreateCompanyOps{
+		Name:    "Acme",
+		City:    "Montreal",
+		Website: "https://acme.example.com",
+	})
+	if err != nil {
+		fmt.Printf("Error creating Company: %s", err.Error())
+		os.Exit(1)
+	}
+	_, err = conn.CreateProject(&teamwork.CreateProjectOps{
+		Name:      "Acme Onboarding",
+		CompanyID: createResponse.ID.String(),
+	})
+	if err != nil {
+		fmt.Printf("Error creating Project: %s", err.Error())
+	}
+
+	// update the company
+	_, err = conn.UpdateCompany(createResponse.ID.String(), &teamwork.UpdateCompanyOps{City: "Toronto"})
+	if err != nil {
+		fmt.Printf("Error updating Company: %s", err.Error())
+	}
+
+	company, err := conn.GetCompany(createResponse.ID.String())
+	if err != nil {
+		fmt.Printf("Error getting Company: %s", err.Error())
+	}
+	fmt.Println("Name:", company.Name)
+	fmt.Println("City:", company.City)
+
+	projects, _, err := conn.GetCompanyProjects(createResponse.ID.String(), &teamwork.GetProjectsOps{})
+	if err != nil {
+		fmt.Printf("Error getting Company Projects: %s", err.Error())
+	}
+	for _, project := range projects {
+		fmt.Println("Project:", project.Name)
+	}
+	// Output:
+	// Name: Acme
+	// City: Toronto
+	// Project: Acme Onboarding
+}
+
+func TestCompanyRequests(t *testing.T) {
+	conn := connectTo(t,
+		apiCall{
+			method:   "POST",
+			path:     "/companies.json",
+			body:     `{"company": {"name": "Acme", "city": "Montreal", "countrycode": "CA", "website": "https://acme.example.com", "industryCatId": "4"}}`,
+			response: `{"id": "12346", "STATUS": "OK"}`,
+		},
+		apiCall{method: "PUT", path: "/companies/12346.json", body: `{"company": {"city": "Toronto", "privateNotes": "Net 30"}}`},
+		apiCall{method: "DELETE", path: "/companies/12346.json"},
+	)
+
+	createResponse, err := conn.CreateCompany(&teamwork.CreateCompanyOps{
+		Name:        "Acme",
+		City:        "Montreal",
+		CountryCode: "CA",
+		Website:     "https://acme.example.com",
+		IndustryID:  "4",
+	})
+	if err != nil || createResponse.ID != "12346" {
+		t.Fatalf("CreateCompany() = %+v, %v, want 12346", createResponse, err)
+	}
+	if _, err := conn.UpdateCompany("12346", &teamwork.UpdateCompanyOps{City: "Toronto", PrivateNotes: "Net 30"}); err != nil {
+		t.Errorf("UpdateCompany() error = %v", err)
+	}
+	if _, err := conn.DeleteCompany("12346"); err != nil {
+		t.Errorf("DeleteCompany() error = %v", err)
+	}
+}
+
+func TestGetAllCompanies(t *testing.T) {
+	conn := connectToPages(t, "/companies.json", "companies", 5)
+	companies, err := conn.GetAllCompanies(&teamwork.GetCompaniesOps{})
+	if err != nil {
+		t.Fatalf("GetAllCompanies() error = %v", err)
+	}
+	if got := pageIDs(companies, func(c teamwork.Company) teamwork.ID { return c.ID }); got != "1,2,3,4,5" {
+		t.Errorf("GetAllCompanies() IDs = %s, want 1,2,3,4,5", got)
+	}
+
+	conn = connectToPages(t, "/companies/12345/projects.json", "projects", 3)
+	projects, err := conn.GetAllCompanyProjects("12345", nil)
+	if err != nil {
+		t.Fatalf("GetAllCompanyProjects() error = %v", err)
+	}
+	if got := pageIDs(projects, func(p teamwork.Project) teamwork.ID { return p.ID }); got != "1,2,3" {
+		t.Errorf("GetAllCompanyProjects() IDs = %s, want 1,2,3", got)
+	}
+}
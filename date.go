@@ -0,0 +1,103 @@
+package teamwork
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timeFormats are the layouts TeamWork uses for times and dates, in the
+// order they are tried.
+var timeFormats = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"20060102T150405Z",
+	"20060102150405",
+	"2006-01-02",
+	"20060102",
+}
+
+// Time is a time.Time which decodes from any of the formats TeamWork
+// sends times in.  An empty string or null decodes as the zero Time,
+// and the zero Time encodes as an empty string.
+type Time struct {
+	time.Time
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(t.Format(time.RFC3339))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	parsed, err := parseTime(data)
+	if err != nil {
+		return fmt.Errorf("teamwork: cannot decode %s into a Time", data)
+	}
+	t.Time = parsed
+	return nil
+}
+
+// Date is a day, without the time, which decodes from any of the formats
+// TeamWork sends dates in (eg: "20200603" or "2020-06-03").  An empty
+// string or null decodes as the zero Date, and the zero Date encodes as an
+// empty string.
+type Date struct {
+	time.Time
+}
+
+// String returns the Date in the "YYYYMMDD" format the API calls take,
+// or an empty string for the zero Date.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.Format("20060102")
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	parsed, err := parseTime(data)
+	if err != nil {
+		return fmt.Errorf("teamwork: cannot decode %s into a Date", data)
+	}
+	if !parsed.IsZero() {
+		year, month, day := parsed.Date()
+		parsed = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	d.Time = parsed
+	return nil
+}
+
+// parseTime parses a JSON string holding a time in one of the timeFormats.
+// Null and empty strings are the zero time.
+func parseTime(data []byte) (time.Time, error) {
+	if string(data) == "null" {
+		return time.Time{}, nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return time.Time{}, err
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return time.Time{}, nil
+	}
+	for _, format := range timeFormats {
+		if parsed, err := time.Parse(format, text); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("teamwork: unknown time format %q", text)
+}
@@ -0,0 +1,81 @@
+package teamwork_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/swill/teamwork"
+)
+
+func TestTime(t *testing.T) {
+	tests := []struct {
+		json string
+		want time.Time
+	}{
+		{`""`, time.Time{}},
+		{`null`, time.Time{}},
+		{`"2020-06-03T15:21:00Z"`, time.Date(2020, 6, 3, 15, 21, 0, 0, time.UTC)},
+		{`"2020-06-03T15:21:00"`, time.Date(2020, 6, 3, 15, 21, 0, 0, time.UTC)},
+		{`"2020-06-03 15:21:00"`, time.Date(2020, 6, 3, 15, 21, 0, 0, time.UTC)},
+		{`"20200603152100"`, time.Date(2020, 6, 3, 15, 21, 0, 0, time.UTC)},
+		{`"20200603"`, time.Date(2020, 6, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		var got teamwork.Time
+		if err := json.Unmarshal([]byte(test.json), &got); err != nil {
+			t.Errorf("Unmarshal(%s) error = %v", test.json, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("Unmarshal(%s) = %v, want %v", test.json, got, test.want)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`"next tuesday"`), &teamwork.Time{}); err == nil {
+		t.Errorf("Unmarshal(next tuesday) error = nil, want an error")
+	}
+	if data, _ := json.Marshal(teamwork.Time{}); string(data) != `""` {
+		t.Errorf("Marshal(zero Time) = %s, want \"\"", data)
+	}
+}
+
+func TestDate(t *testing.T) {
+	var person teamwork.Person
+	err := json.Unmarshal([]byte(`{"last-changed-on": "", "last-login": "2020-06-03T15:21:00Z", "user-invited-date": null}`), &person)
+	if err != nil {
+		t.Fatalf("Unmarshal(Person) error = %v", err)
+	}
+	if !person.LastChangedOn.IsZero() || !person.UserInvitedDate.IsZero() || person.LastLogin.Year() != 2020 {
+		t.Errorf("Unmarshal(Person) = %v, %v, %v, want zero, 2020, zero", person.LastChangedOn, person.LastLogin, person.UserInvitedDate)
+	}
+
+	var task teamwork.Task
+	err = json.Unmarshal([]byte(`{"start-date": "20200603", "due-date": "2020-06-10T17:00:00Z", "completed_on": "", "created-on": "2020-06-03T15:21:00Z", "last-changed-on": null}`), &task)
+	if err != nil {
+		t.Fatalf("Unmarshal(Task) error = %v", err)
+	}
+	if task.StartDate.String() != "20200603" || task.DueDate.String() != "20200610" {
+		t.Errorf("Unmarshal(Task) dates = %s, %s, want 20200603, 20200610", task.StartDate, task.DueDate)
+	}
+	if !task.CompletedOn.IsZero() || !task.LastChangedOn.IsZero() || task.CreatedOn.Year() != 2020 {
+		t.Errorf("Unmarshal(Task) times = %v, %v, %v, want zero, 2020, zero", task.CompletedOn, task.CreatedOn, task.LastChangedOn)
+	}
+
+	var company teamwork.Company
+	err = json.Unmarshal([]byte(`{"created-on": "2020-06-03T15:21:00Z", "last-changed-on": ""}`), &company)
+	if err != nil {
+		t.Fatalf("Unmarshal(Company) error = %v", err)
+	}
+	if company.CreatedOn.Year() != 2020 || !company.LastChangedOn.IsZero() {
+		t.Errorf("Unmarshal(Company) times = %v, %v, want 2020, zero", company.CreatedOn, company.LastChangedOn)
+	}
+	if days := task.DueDate.Sub(task.StartDate.Time).Hours() / 24; days != 7 {
+		t.Errorf("task is %v days, want 7", days)
+	}
+
+	data, err := json.Marshal(task.StartDate)
+	if err != nil || string(data) != `"20200603"` {
+		t.Errorf("Marshal(Date) = %s, %v, want \"20200603\"", data, err)
+	}
+}
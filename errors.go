@@ -0,0 +1,80 @@
+package teamwork
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// APIError is returned when TeamWork responds with a non 2xx status code.
+// The Message and Status are populated from the `MESSAGE` and `STATUS`
+// fields TeamWork includes in the body of most error responses.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Message    string
+	Status     string
+	Body       []byte
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("teamwork: %s %s: %d %s: %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+	}
+	return fmt.Sprintf("teamwork: %s %s: %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// newAPIError builds an APIError from a response with a non 2xx status code.
+// The response body is read, but not closed.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.URL = resp.Request.URL.String()
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return apiErr
+	}
+	apiErr.Body = body
+
+	fields := struct {
+		Message string `json:"MESSAGE"`
+		Status  string `json:"STATUS"`
+	}{}
+	if err := json.Unmarshal(body, &fields); err == nil {
+		apiErr.Message = fields.Message
+		apiErr.Status = fields.Status
+	}
+	return apiErr
+}
+
+// IsNotFound reports whether err is an APIError with a 404 status code.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an APIError with a 401 status code.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsRateLimited reports whether err is an APIError with a 429 status code.
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// hasStatusCode reports whether err is an APIError with the status code.
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == statusCode
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package teamwork_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	"github.com/swill/teamwork"
+)
+
+func ExampleIsNotFound() {
+	// a stand-in for TeamWork which does not know about any project
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"MESSAGE": "Project not found", "STATUS": "Error"}`)
+	}))
+	defer server.Close()
+
+	// setup the teamwork connection
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken")
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	_, err = conn.GetProject("404", &teamwork.GetProjectOps{})
+	if teamwork.IsNotFound(err) {
+		apiErr := err.(*teamwork.APIError)
+		fmt.Println("Status Code:", apiErr.StatusCode)
+		fmt.Println("Message:", apiErr.Message)
+	}
+	// Output:
+	// Status Code: 404
+	// Message: Project not found
+}
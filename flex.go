@@ -0,0 +1,128 @@
+package teamwork
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The TeamWork API is not consistent about the JSON types it uses, eg: an ID
+// is a string from one endpoint and a number from the next, and a flag is
+// true in one response and "1" in another.  The types below decode any of
+// the forms TeamWork is known to send, and encode as the Go type they wrap.
+
+// ID is the ID of a TeamWork item.  It decodes from a JSON string or number.
+type ID string
+
+// String returns the ID as a string, for the calls which take an ID.
+func (id ID) String() string {
+	return string(id)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	text, err := flexText(data)
+	if err != nil {
+		return fmt.Errorf("teamwork: cannot decode %s into an ID", data)
+	}
+	*id = ID(text)
+	return nil
+}
+
+// FlexInt is an int which decodes from a JSON number or a string holding
+// one.  An empty string or null decodes as 0.
+type FlexInt int
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (i *FlexInt) UnmarshalJSON(data []byte) error {
+	text, err := flexText(data)
+	if err != nil {
+		return fmt.Errorf("teamwork: cannot decode %s into a FlexInt", data)
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		*i = 0
+		return nil
+	}
+	if n, err := strconv.ParseInt(text, 10, 0); err == nil {
+		*i = FlexInt(n)
+		return nil
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return fmt.Errorf("teamwork: cannot decode %s into a FlexInt", data)
+	}
+	*i = FlexInt(f)
+	return nil
+}
+
+// FlexBool is a bool which decodes from a JSON bool, a number (non-zero is
+// true) or a string holding either, or "yes" and "no".  An empty string or
+// null decodes as false.
+type FlexBool bool
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (b *FlexBool) UnmarshalJSON(data []byte) error {
+	text, err := flexText(data)
+	if err != nil {
+		return fmt.Errorf("teamwork: cannot decode %s into a FlexBool", data)
+	}
+	switch strings.ToLower(strings.TrimSpace(text)) {
+	case "", "false", "no", "n", "off":
+		*b = false
+		return nil
+	case "true", "yes", "y", "on":
+		*b = true
+		return nil
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+	if err != nil {
+		return fmt.Errorf("teamwork: cannot decode %s into a FlexBool", data)
+	}
+	*b = f != 0
+	return nil
+}
+
+// FlexString is a string which decodes from a JSON string, number or bool.
+// Null decodes as an empty string.
+type FlexString string
+
+// String returns the FlexString as a string.
+func (s FlexString) String() string {
+	return string(s)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *FlexString) UnmarshalJSON(data []byte) error {
+	text, err := flexText(data)
+	if err != nil {
+		return fmt.Errorf("teamwork: cannot decode %s into a FlexString", data)
+	}
+	*s = FlexString(text)
+	return nil
+}
+
+// flexText returns the text of a JSON string, number or bool.  Null is
+// empty, and objects and arrays are an error.
+func flexText(data []byte) (string, error) {
+	data = bytes.TrimSpace(data)
+	switch {
+	case len(data) == 0:
+		return "", fmt.Errorf("teamwork: no JSON value")
+	case string(data) == "null":
+		return "", nil
+	case data[0] == '"':
+		var text string
+		err := json.Unmarshal(data, &text)
+		return text, err
+	case string(data) == "true", string(data) == "false":
+		return string(data), nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return "", err
+	}
+	return number.String(), nil
+}
@@ -0,0 +1,59 @@
+package teamwork_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/swill/teamwork"
+)
+
+func TestFlexTypes(t *testing.T) {
+	tests := []struct {
+		json string
+		want teamwork.Task
+	}{
+		{
+			json: `{"id": 4486838, "project-id": "158721", "completed": true, "private": "1", "progress": 50}`,
+			want: teamwork.Task{ID: "4486838", ProjectID: "158721", Completed: true, Private: 1, Progress: 50},
+		},
+		{
+			json: `{"id": "4486838", "project-id": 158721, "completed": "1", "private": 1, "progress": "50"}`,
+			want: teamwork.Task{ID: "4486838", ProjectID: "158721", Completed: true, Private: 1, Progress: 50},
+		},
+		{
+			json: `{"id": null, "project-id": "", "completed": "false", "private": "", "progress": null}`,
+			want: teamwork.Task{},
+		},
+		{
+			json: `{"completed": "yes", "estimated-minutes": 90.0, "timeIsLogged": 0}`,
+			want: teamwork.Task{Completed: true, EstimatedMinutes: 90},
+		},
+	}
+	for _, test := range tests {
+		got := teamwork.Task{}
+		if err := json.Unmarshal([]byte(test.json), &got); err != nil {
+			t.Errorf("Unmarshal(%s) error = %v", test.json, err)
+			continue
+		}
+		if got.ID != test.want.ID || got.ProjectID != test.want.ProjectID || got.Completed != test.want.Completed ||
+			got.Private != test.want.Private || got.Progress != test.want.Progress || got.EstimatedMinutes != test.want.EstimatedMinutes {
+			t.Errorf("Unmarshal(%s) = %+v, want %+v", test.json, got, test.want)
+		}
+	}
+
+	var timeEntry teamwork.TimeEntry
+	if err := json.Unmarshal([]byte(`{"hours": 2, "minutes": "30"}`), &timeEntry); err != nil || timeEntry.Hours != "2" || timeEntry.Minutes != "30" {
+		t.Errorf("Unmarshal(FlexString) = %q, %q, %v, want 2, 30", timeEntry.Hours, timeEntry.Minutes, err)
+	}
+
+	if data, err := json.Marshal(teamwork.Task{Completed: true}); err != nil || !strings.Contains(string(data), `"completed":true`) {
+		t.Errorf("Marshal(FlexBool) = %s, %v, want true", data, err)
+	}
+
+	for _, bad := range []string{`{"id": {}}`, `{"progress": "lots"}`, `{"completed": "maybe"}`} {
+		if err := json.Unmarshal([]byte(bad), &teamwork.Task{}); err == nil {
+			t.Errorf("Unmarshal(%s) error = nil, want an error", bad)
+		}
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/swill/teamwork
 
-go 1.14
+go 1.23
@@ -0,0 +1,299 @@
+// Command mockgen generates the mocks in the teamworkmock package from the
+// interfaces declared in the teamwork package.
+//
+//	go run ./internal/mockgen -src . -iface Client -out teamworkmock/client.go
+//
+// Each method of the interface gets a ...Func field on the mock which is
+// called by the method, and every call is recorded.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	src   = flag.String("src", ".", "the directory of the teamwork package")
+	iface = flag.String("iface", "Client", "the interface to mock")
+	pkg   = flag.String("pkg", "teamworkmock", "the package of the mock")
+	out   = flag.String("out", "", "the file to write the mock to (default: stdout)")
+)
+
+// method is a method of the mocked interface.
+type method struct {
+	name     string
+	params   []param
+	results  []string
+	variadic bool
+}
+
+// param is a parameter of a method.
+type param struct {
+	name string
+	typ  string
+}
+
+// generator collects what is needed to write the mock.
+type generator struct {
+	srcPkg     string
+	interfaces map[string]*ast.InterfaceType
+	fileOf     map[string]*ast.File
+	imports    map[string]string // name -> path
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mockgen: ")
+	flag.Parse()
+
+	g := &generator{
+		interfaces: map[string]*ast.InterfaceType{},
+		fileOf:     map[string]*ast.File{},
+		imports:    map[string]string{},
+	}
+	if err := g.parse(*src); err != nil {
+		log.Fatal(err)
+	}
+	methods, err := g.methods(*iface)
+	if err != nil {
+		log.Fatal(err)
+	}
+	code, err := g.generate(methods)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *out == "" {
+		os.Stdout.Write(code)
+		return
+	}
+	if err := os.WriteFile(*out, code, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse collects the interfaces declared in the package in dir.
+func (g *generator) parse(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		g.srcPkg = file.Name.Name
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if it, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+					g.interfaces[typeSpec.Name.Name] = it
+					g.fileOf[typeSpec.Name.Name] = file
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// methods returns the methods of the interface, including the embedded ones.
+func (g *generator) methods(name string) ([]method, error) {
+	it, ok := g.interfaces[name]
+	if !ok {
+		return nil, fmt.Errorf("interface %s not found in %s", name, *src)
+	}
+	file := g.fileOf[name]
+	methods := []method{}
+	for _, field := range it.Methods.List {
+		if len(field.Names) == 0 { // embedded interface
+			ident, ok := field.Type.(*ast.Ident)
+			if !ok {
+				return nil, fmt.Errorf("unsupported embedded interface in %s", name)
+			}
+			embedded, err := g.methods(ident.Name)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, embedded...)
+			continue
+		}
+		fn := field.Type.(*ast.FuncType)
+		m := method{name: field.Names[0].Name}
+		for _, p := range fn.Params.List {
+			typ := g.typeString(file, p.Type)
+			if _, ok := p.Type.(*ast.Ellipsis); ok {
+				m.variadic = true
+			}
+			if len(p.Names) == 0 {
+				m.params = append(m.params, param{name: "p" + strconv.Itoa(len(m.params)), typ: typ})
+			}
+			for _, n := range p.Names {
+				m.params = append(m.params, param{name: n.Name, typ: typ})
+			}
+		}
+		if fn.Results != nil {
+			for _, r := range fn.Results.List {
+				count := max(len(r.Names), 1)
+				for i := 0; i < count; i++ {
+					m.results = append(m.results, g.typeString(file, r.Type))
+				}
+			}
+		}
+		methods = append(methods, m)
+	}
+	return methods, nil
+}
+
+// typeString prints a type expression as seen from the mock package,
+// qualifying the types of the teamwork package and recording the imports.
+func (g *generator) typeString(file *ast.File, expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			g.imports[g.srcPkg] = "github.com/swill/teamwork"
+			return g.srcPkg + "." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		name := t.X.(*ast.Ident).Name
+		for _, imp := range file.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			if (imp.Name != nil && imp.Name.Name == name) || (imp.Name == nil && filepath.Base(path) == name) {
+				g.imports[name] = path
+			}
+		}
+		return name + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(file, t.X)
+	case *ast.ArrayType:
+		return "[]" + g.typeString(file, t.Elt)
+	case *ast.Ellipsis:
+		return "..." + g.typeString(file, t.Elt)
+	case *ast.MapType:
+		return "map[" + g.typeString(file, t.Key) + "]" + g.typeString(file, t.Value)
+	case *ast.IndexExpr:
+		return g.typeString(file, t.X) + "[" + g.typeString(file, t.Index) + "]"
+	case *ast.IndexListExpr:
+		indices := make([]string, len(t.Indices))
+		for i, index := range t.Indices {
+			indices[i] = g.typeString(file, index)
+		}
+		return g.typeString(file, t.X) + "[" + strings.Join(indices, ", ") + "]"
+	case *ast.InterfaceType:
+		return "interface{}"
+	}
+	log.Fatalf("unsupported type %T", expr)
+	return ""
+}
+
+// generate writes the mock for the methods.
+func (g *generator) generate(methods []method) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by mockgen -iface %s; DO NOT EDIT.\n\n", *iface)
+	fmt.Fprintf(buf, "package %s\n\n", *pkg)
+
+	var std, others []string
+	for _, path := range g.imports {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	buf.WriteString("import (\n")
+	for _, path := range std {
+		fmt.Fprintf(buf, "\t%q\n", path)
+	}
+	if len(std) > 0 && len(others) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, path := range others {
+		fmt.Fprintf(buf, "\t%q\n", path)
+	}
+	buf.WriteString(")\n\n")
+
+	fmt.Fprintf(buf, "// %s is a mock of %s.%s.  Set the ...Func field of each method the\n", *iface, g.srcPkg, *iface)
+	fmt.Fprintf(buf, "// code under test calls.  Calling a method whose ...Func is not set panics.\n")
+	fmt.Fprintf(buf, "type %s struct {\n", *iface)
+	for _, m := range methods {
+		fmt.Fprintf(buf, "\t%sFunc func(%s) %s\n", m.name, m.paramList(), m.resultList())
+	}
+	buf.WriteString("\n\tcalls recorder\n}\n\n")
+	fmt.Fprintf(buf, "var _ %s.%s = (*%s)(nil)\n\n", g.srcPkg, *iface, *iface)
+
+	fmt.Fprintf(buf, "// Calls returns the calls made to the mock, in order.\n")
+	fmt.Fprintf(buf, "func (mock *%s) Calls() []Call {\n\treturn mock.calls.all()\n}\n\n", *iface)
+	fmt.Fprintf(buf, "// CallsTo returns the calls made to a method of the mock, in order.\n")
+	fmt.Fprintf(buf, "func (mock *%s) CallsTo(method string) []Call {\n\treturn mock.calls.to(method)\n}\n", *iface)
+
+	for _, m := range methods {
+		args := m.argList()
+		fmt.Fprintf(buf, "\n// %s calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(buf, "func (mock *%s) %s(%s) %s {\n", *iface, m.name, m.paramList(), m.resultList())
+		if len(m.params) > 0 {
+			fmt.Fprintf(buf, "\tmock.calls.record(%q, %s)\n", m.name, strings.TrimSuffix(args, "..."))
+		} else {
+			fmt.Fprintf(buf, "\tmock.calls.record(%q)\n", m.name)
+		}
+		fmt.Fprintf(buf, "\tif mock.%sFunc == nil {\n\t\tpanic(%q)\n\t}\n", m.name, *pkg+": "+*iface+"."+m.name+"Func is not set")
+		if len(m.results) > 0 {
+			fmt.Fprintf(buf, "\treturn mock.%sFunc(%s)\n}\n", m.name, args)
+		} else {
+			fmt.Fprintf(buf, "\tmock.%sFunc(%s)\n}\n", m.name, args)
+		}
+	}
+	return format.Source(buf.Bytes())
+}
+
+// paramList is the parameters of the method with their types.
+func (m method) paramList() string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		params[i] = p.name + " " + p.typ
+	}
+	return strings.Join(params, ", ")
+}
+
+// resultList is the results of the method.
+func (m method) resultList() string {
+	switch len(m.results) {
+	case 0:
+		return ""
+	case 1:
+		return m.results[0]
+	}
+	return "(" + strings.Join(m.results, ", ") + ")"
+}
+
+// argList is the parameters of the method passed on to another call.
+func (m method) argList() string {
+	args := make([]string, len(m.params))
+	for i, p := range m.params {
+		args[i] = p.name
+	}
+	if m.variadic {
+		args[len(args)-1] += "..."
+	}
+	return strings.Join(args, ", ")
+}
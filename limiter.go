@@ -0,0 +1,89 @@
+package teamwork
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket which keeps the calls made to TeamWork under
+// the quota of an account.  A Limiter is safe for concurrent use and can be
+// shared between several Connection(s) to the same account with WithLimiter.
+type Limiter struct {
+	mu       sync.Mutex
+	interval time.Duration // time to add one token
+	burst    float64
+	tokens   float64
+	last     time.Time
+}
+
+// NewLimiter returns a Limiter which allows perMinute calls per minute,
+// with bursts of up to burst calls.  The bucket starts full.
+func NewLimiter(perMinute, burst int) *Limiter {
+	if perMinute < 1 {
+		perMinute = 1
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &Limiter{
+		interval: time.Minute / time.Duration(perMinute),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until a call is allowed or ctx is done.  If ctx is done
+// first, the reserved call is given back and the ctx error is returned.
+func (l *Limiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		l.cancel()
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token from the bucket and returns how long to wait
+// until that token is actually available.
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += float64(now.Sub(l.last)) / float64(l.interval)
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// cancel gives back a token which was reserved but not used.  The bucket
+// never holds more than burst tokens.
+func (l *Limiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
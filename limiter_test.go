@@ -0,0 +1,45 @@
+package teamwork_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/swill/teamwork"
+)
+
+func ExampleNewLimiter() {
+	// share one limiter between every connection to the account
+	limiter := teamwork.NewLimiter(120, 10)
+
+	baseURL := "a teamwork baseURL"
+	apiToken := "a_teamwork_apiToken"
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithLimiter(limiter))
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+	other, err := teamwork.Connect(baseURL, apiToken, teamwork.WithLimiter(limiter))
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+	fmt.Printf("%+v %+v", conn, other) // this is just so the go linter doesn't complain about "conn defined and not used"
+}
+
+func ExampleLimiter_Wait() {
+	// one call a minute
+	limiter := teamwork.NewLimiter(1, 1)
+
+	// the first call uses the burst
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	fmt.Println("1.", limiter.Wait(ctx))
+
+	// the second call would have to wait a minute
+	fmt.Println("2.", limiter.Wait(ctx))
+	// Output:
+	// 1. <nil>
+	// 2. context deadline exceeded
+}
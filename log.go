@@ -0,0 +1,48 @@
+package teamwork
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"regexp"
+)
+
+// authorizationHeader matches the Authorization header in a request dump.
+var authorizationHeader = regexp.MustCompile(`(?mi)^(Authorization:) .*$`)
+
+// debugRequest logs a dump of the request, with the API token redacted.
+func (conn *Connection) debugRequest(req *http.Request) {
+	dump, err := httputil.DumpRequestOut(req, true)
+	if err != nil {
+		conn.logger.Debug("teamwork: failed to dump request", "error", err)
+		return
+	}
+	dump = authorizationHeader.ReplaceAll(dump, []byte("$1 REDACTED\r"))
+	conn.logger.Debug("teamwork: request", "method", req.Method, "url", req.URL.String(), "dump", string(dump))
+}
+
+// debugResponse logs a dump of the response.
+func (conn *Connection) debugResponse(resp *http.Response) {
+	dump, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		conn.logger.Debug("teamwork: failed to dump response", "error", err)
+		return
+	}
+	conn.logger.Debug("teamwork: response", "status", resp.StatusCode, "dump", string(dump))
+}
+
+// debugLogger is the logger used by WithDebug when no logger is set.
+func debugLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+// discardHandler is a slog.Handler which drops every record.
+// It is used when no logger is set.
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
@@ -0,0 +1,43 @@
+package teamwork_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/swill/teamwork"
+)
+
+func TestWithDebugRedactsToken(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+	}))
+	defer server.Close()
+
+	for _, apiToken := range []string{"a_teamwork_apiToken", "tkn.v1_a_teamwork_apiToken"} {
+		buf := &bytes.Buffer{}
+		logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		_, err := teamwork.Connect(server.URL, apiToken, teamwork.WithLogger(logger), teamwork.WithDebug(true))
+		if err != nil {
+			t.Fatalf("Connect() error = %v", err)
+		}
+
+		logged := buf.String()
+		basic := base64.StdEncoding.EncodeToString([]byte(apiToken + ":notused"))
+		if strings.Contains(logged, apiToken) || strings.Contains(logged, basic) {
+			t.Errorf("debug log contains the API token:\n%s", logged)
+		}
+		if !strings.Contains(logged, "Authorization: REDACTED") {
+			t.Errorf("debug log does not contain the redacted request:\n%s", logged)
+		}
+		if !strings.Contains(logged, "authenticate.json") || !strings.Contains(logged, "200 OK") {
+			t.Errorf("debug log does not contain the request and response:\n%s", logged)
+		}
+	}
+}
@@ -0,0 +1,370 @@
+package teamwork
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// Milestones is a list of Milestone
+type Milestones []Milestone
+
+// The Milestone structure.
+type Milestone struct {
+	CanComplete               FlexBool  `json:"can-complete"`
+	CanEdit                   FlexBool  `json:"canEdit"`
+	CommentsCount             FlexInt   `json:"comments-count"`
+	CompanyID                 ID        `json:"company-id"`
+	CompanyName               string    `json:"company-name"`
+	Completed                 FlexBool  `json:"completed"`
+	CompletedOn               Time      `json:"completed-on,omitempty"`
+	CompleterFirstname        string    `json:"completer-firstname,omitempty"`
+	CompleterID               ID        `json:"completer-id,omitempty"`
+	CompleterLastname         string    `json:"completer-lastname,omitempty"`
+	CreatedOn                 Time      `json:"created-on"`
+	CreatorFirstname          string    `json:"creator-firstname"`
+	CreatorID                 ID        `json:"creator-id"`
+	CreatorLastname           string    `json:"creator-lastname"`
+	Deadline                  Date      `json:"deadline"`
+	Description               string    `json:"description"`
+	ID                        ID        `json:"id"`
+	LastChangedOn             Time      `json:"last-changed-on"`
+	PercentageComplete        FlexInt   `json:"percentageComplete,omitempty"` // with GetProgress
+	Private                   FlexBool  `json:"private"`
+	ProjectID                 ID        `json:"project-id"`
+	ProjectName               string    `json:"project-name"`
+	Reminder                  FlexBool  `json:"reminder"`
+	ResponsiblePartyFirstname string    `json:"responsible-party-firstname,omitempty"`
+	ResponsiblePartyID        ID        `json:"responsible-party-id,omitempty"`
+	ResponsiblePartyIds       string    `json:"responsible-party-ids,omitempty"`
+	ResponsiblePartyLastname  string    `json:"responsible-party-lastname,omitempty"`
+	ResponsiblePartyNames     string    `json:"responsible-party-names,omitempty"`
+	ResponsiblePartyType      string    `json:"responsible-party-type,omitempty"`
+	Status                    string    `json:"status"`
+	TaskLists                 TaskLists `json:"tasklists,omitempty"` // with ShowTaskLists
+	Title                     string    `json:"title"`
+}
+
+// GetMilestonesOps is used to generate the query params for the
+// GetMilestones and GetProjectMilestones API calls.
+type GetMilestonesOps struct {
+	// Query milestones based on these values.
+	//
+	// Valid Input: "all", "completed", "incomplete", "late", "upcoming"
+	// Default: "all"
+	Find string `param:"find"`
+	// Return the percentage of the tasks completed for each milestone.
+	// Valid Input: true, false
+	GetProgress *bool `param:"getProgress"`
+	// Return the task lists of each milestone.
+	// Valid Input: true, false
+	ShowTaskLists *bool `param:"showTaskLists"`
+	// Return the tasks of the task lists of each milestone.
+	// Valid Input: true, false
+	ShowTasks *bool `param:"showTasks"`
+	// A page of results.  Access additional pages.  (eg: 2, etc...)
+	Page *int `param:"page"`
+}
+
+// GetMilestones gets all the milestones available according to the specified
+// GetMilestonesOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/milestones/get-milestones-json
+func (conn *Connection) GetMilestones(ops *GetMilestonesOps) (Milestones, Pages, error) {
+	return conn.GetMilestonesContext(context.Background(), ops)
+}
+
+// GetMilestonesContext is like GetMilestones but uses ctx for the request.
+func (conn *Connection) GetMilestonesContext(ctx context.Context, ops *GetMilestonesOps) (Milestones, Pages, error) {
+	return conn.getMilestones(ctx, fmt.Sprintf("%smilestones.json", conn.baseURL), ops)
+}
+
+// AllMilestones returns an iterator over all the milestones according to the
+// specified GetMilestonesOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllMilestones(ctx context.Context, ops *GetMilestonesOps) iter.Seq2[Milestone, error] {
+	return conn.milestonesPager(ops).all(ctx)
+}
+
+// milestonesPager fetches the pages for AllMilestones and GetAllMilestones.
+func (conn *Connection) milestonesPager(ops *GetMilestonesOps) pager[Milestone] {
+	pageOps := GetMilestonesOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Milestone]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Milestone, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetMilestonesContext(ctx, &ops)
+		},
+	}
+}
+
+// GetAllMilestones gets all the milestones according to the specified
+// GetMilestonesOps, walking every page.
+func (conn *Connection) GetAllMilestones(ops *GetMilestonesOps) (Milestones, error) {
+	return conn.GetAllMilestonesContext(context.Background(), ops)
+}
+
+// GetAllMilestonesContext is like GetAllMilestones but uses ctx for the requests.
+func (conn *Connection) GetAllMilestonesContext(ctx context.Context, ops *GetMilestonesOps) (Milestones, error) {
+	return conn.milestonesPager(ops).collect(ctx, conn.pageWorkers)
+}
+
+// GetProjectMilestones gets all the milestones of a project according to the
+// specified GetMilestonesOps and project id passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/milestones/get-projects-id-milestones-json
+func (conn *Connection) GetProjectMilestones(id string, ops *GetMilestonesOps) (Milestones, Pages, error) {
+	return conn.GetProjectMilestonesContext(context.Background(), id, ops)
+}
+
+// GetProjectMilestonesContext is like GetProjectMilestones but uses ctx for the request.
+func (conn *Connection) GetProjectMilestonesContext(ctx context.Context, id string, ops *GetMilestonesOps) (Milestones, Pages, error) {
+	return conn.getMilestones(ctx, fmt.Sprintf("%sprojects/%s/milestones.json", conn.baseURL, id), ops)
+}
+
+// AllProjectMilestones returns an iterator over all the project milestones according to the
+// specified GetMilestonesOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllProjectMilestones(ctx context.Context, id string, ops *GetMilestonesOps) iter.Seq2[Milestone, error] {
+	return conn.projectMilestonesPager(id, ops).all(ctx)
+}
+
+// projectMilestonesPager fetches the pages for AllProjectMilestones and GetAllProjectMilestones.
+func (conn *Connection) projectMilestonesPager(id string, ops *GetMilestonesOps) pager[Milestone] {
+	pageOps := GetMilestonesOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Milestone]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Milestone, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetProjectMilestonesContext(ctx, id, &ops)
+		},
+	}
+}
+
+// GetAllProjectMilestones gets all the project milestones according to the specified
+// GetMilestonesOps, walking every page.
+func (conn *Connection) GetAllProjectMilestones(id string, ops *GetMilestonesOps) (Milestones, error) {
+	return conn.GetAllProjectMilestonesContext(context.Background(), id, ops)
+}
+
+// GetAllProjectMilestonesContext is like GetAllProjectMilestones but uses ctx for the requests.
+func (conn *Connection) GetAllProjectMilestonesContext(ctx context.Context, id string, ops *GetMilestonesOps) (Milestones, error) {
+	return conn.projectMilestonesPager(id, ops).collect(ctx, conn.pageWorkers)
+}
+
+// getMilestones gets a page of the milestones at url.
+func (conn *Connection) getMilestones(ctx context.Context, url string, ops *GetMilestonesOps) (Milestones, Pages, error) {
+	milestones := make(Milestones, 0)
+	pages := &Pages{}
+	params := buildParams(ops)
+	method := "GET"
+	reader, headers, err := conn.request(ctx, method, url+params, nil)
+	if err != nil {
+		return milestones, *pages, err
+	}
+	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return milestones, *pages, err
+	}
+
+	err = json.NewDecoder(reader).Decode(&struct {
+		*Milestones `json:"milestones"`
+	}{&milestones})
+	if err != nil {
+		return milestones, *pages, err
+	}
+
+	return milestones, *pages, nil
+}
+
+// GetMilestone gets a single milestone based on the ID.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/milestones/get-milestones-id-json
+func (conn *Connection) GetMilestone(id string) (Milestone, error) {
+	return conn.GetMilestoneContext(context.Background(), id)
+}
+
+// GetMilestoneContext is like GetMilestone but uses ctx for the request.
+func (conn *Connection) GetMilestoneContext(ctx context.Context, id string) (Milestone, error) {
+	milestone := &Milestone{}
+	method := "GET"
+	url := fmt.Sprintf("%smilestones/%s.json", conn.baseURL, id)
+	reader, _, err := conn.request(ctx, method, url, nil)
+	if err != nil {
+		return *milestone, err
+	}
+	defer reader.Close()
+
+	err = json.NewDecoder(reader).Decode(&struct {
+		*Milestone `json:"milestone"`
+	}{milestone})
+	if err != nil {
+		return *milestone, err
+	}
+
+	return *milestone, nil
+}
+
+// CreateMilestoneOps is used to generate the body for the
+// CreateMilestone API call.
+type CreateMilestoneOps struct {
+	// The title of the milestone.
+	Title string `json:"title"`
+	// The description of the milestone.
+	Description string `json:"description,omitempty"`
+	// Format: "20100603"
+	Deadline string `json:"deadline"`
+	// A comma separated list of the IDs of the people responsible.
+	ResponsiblePartyIDs string `json:"responsible-party-ids"`
+	// Email the people responsible about the milestone.
+	// Valid Input: true, false
+	Notify *bool `json:"notify,omitempty"`
+	// Remind the people responsible before the deadline.
+	// Valid Input: true, false
+	Reminder *bool `json:"reminder,omitempty"`
+	// Valid Input: true, false
+	Private *bool `json:"private,omitempty"`
+	// A comma separated list of the IDs of the task lists to attach.
+	TaskListIDs string `json:"tasklistIds,omitempty"`
+	// A comma separated list of tags.
+	Tags string `json:"tags,omitempty"`
+}
+
+// CreateMilestoneResponse captures the response returned from a create milestone action
+type CreateMilestoneResponse struct {
+	ID     ID     `json:"milestoneId"`
+	Status string `json:"STATUS"`
+}
+
+// CreateMilestone adds a milestone to a project according to the specified
+// CreateMilestoneOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/milestones/post-projects-id-milestones-json
+func (conn *Connection) CreateMilestone(projectID string, ops *CreateMilestoneOps) (*CreateMilestoneResponse, error) {
+	return conn.CreateMilestoneContext(context.Background(), projectID, ops)
+}
+
+// CreateMilestoneContext is like CreateMilestone but uses ctx for the request.
+func (conn *Connection) CreateMilestoneContext(ctx context.Context, projectID string, ops *CreateMilestoneOps) (*CreateMilestoneResponse, error) {
+	createResponse := &CreateMilestoneResponse{}
+	url := fmt.Sprintf("%sprojects/%s/milestones.json", conn.baseURL, projectID)
+	err := conn.sendJSON(ctx, "POST", url, struct {
+		Milestone *CreateMilestoneOps `json:"milestone"`
+	}{ops}, createResponse)
+	if err != nil {
+		return nil, err
+	}
+	return createResponse, nil
+}
+
+// UpdateMilestoneOps is used to generate the body for the
+// UpdateMilestone API call.  Only the fields which are set are updated.
+type UpdateMilestoneOps struct {
+	// The title of the milestone.
+	Title string `json:"title,omitempty"`
+	// The description of the milestone.
+	Description string `json:"description,omitempty"`
+	// Format: "20100603"
+	Deadline string `json:"deadline,omitempty"`
+	// Move the deadlines of the upcoming milestones by as much as this one moves.
+	// Valid Input: true, false
+	MoveUpcomingMilestones *bool `json:"move-upcoming-milestones,omitempty"`
+	// Keep the moved deadlines off weekends.
+	// Valid Input: true, false
+	MoveUpcomingMilestonesOffWeekends *bool `json:"move-upcoming-milestones-off-weekends,omitempty"`
+	// A comma separated list of the IDs of the people responsible.
+	ResponsiblePartyIDs string `json:"responsible-party-ids,omitempty"`
+	// Email the people responsible about the change.
+	// Valid Input: true, false
+	Notify *bool `json:"notify,omitempty"`
+	// Remind the people responsible before the deadline.
+	// Valid Input: true, false
+	Reminder *bool `json:"reminder,omitempty"`
+	// Valid Input: true, false
+	Private *bool `json:"private,omitempty"`
+	// A comma separated list of the IDs of the task lists to attach.
+	TaskListIDs string `json:"tasklistIds,omitempty"`
+	// A comma separated list of tags.
+	Tags string `json:"tags,omitempty"`
+}
+
+// UpdateMilestone updates a milestone according to the specified
+// UpdateMilestoneOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/milestones/put-milestones-id-json
+func (conn *Connection) UpdateMilestone(id string, ops *UpdateMilestoneOps) (*StatusResponse, error) {
+	return conn.UpdateMilestoneContext(context.Background(), id, ops)
+}
+
+// UpdateMilestoneContext is like UpdateMilestone but uses ctx for the request.
+func (conn *Connection) UpdateMilestoneContext(ctx context.Context, id string, ops *UpdateMilestoneOps) (*StatusResponse, error) {
+	updateResponse := &StatusResponse{}
+	url := fmt.Sprintf("%smilestones/%s.json", conn.baseURL, id)
+	err := conn.sendJSON(ctx, "PUT", url, struct {
+		Milestone *UpdateMilestoneOps `json:"milestone"`
+	}{ops}, updateResponse)
+	if err != nil {
+		return nil, err
+	}
+	return updateResponse, nil
+}
+
+// CompleteMilestone marks a milestone as complete.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/milestones/put-milestones-id-complete-json
+func (conn *Connection) CompleteMilestone(id string) (*StatusResponse, error) {
+	return conn.CompleteMilestoneContext(context.Background(), id)
+}
+
+// CompleteMilestoneContext is like CompleteMilestone but uses ctx for the request.
+func (conn *Connection) CompleteMilestoneContext(ctx context.Context, id string) (*StatusResponse, error) {
+	completeResponse := &StatusResponse{}
+	url := fmt.Sprintf("%smilestones/%s/complete.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "PUT", url, nil, completeResponse); err != nil {
+		return nil, err
+	}
+	return completeResponse, nil
+}
+
+// UncompleteMilestone marks a complete milestone as not complete.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/milestones/put-milestones-id-uncomplete-json
+func (conn *Connection) UncompleteMilestone(id string) (*StatusResponse, error) {
+	return conn.UncompleteMilestoneContext(context.Background(), id)
+}
+
+// UncompleteMilestoneContext is like UncompleteMilestone but uses ctx for the request.
+func (conn *Connection) UncompleteMilestoneContext(ctx context.Context, id string) (*StatusResponse, error) {
+	uncompleteResponse := &StatusResponse{}
+	url := fmt.Sprintf("%smilestones/%s/uncomplete.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "PUT", url, nil, uncompleteResponse); err != nil {
+		return nil, err
+	}
+	return uncompleteResponse, nil
+}
+
+// DeleteMilestone deletes a milestone.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/milestones/delete-milestones-id-json
+func (conn *Connection) DeleteMilestone(id string) (*StatusResponse, error) {
+	return conn.DeleteMilestoneContext(context.Background(), id)
+}
+
+// DeleteMilestoneContext is like DeleteMilestone but uses ctx for the request.
+func (conn *Connection) DeleteMilestoneContext(ctx context.Context, id string) (*StatusResponse, error) {
+	deleteResponse := &StatusResponse{}
+	url := fmt.Sprintf("%smilestones/%s.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "DELETE", url, nil, deleteResponse); err != nil {
+		return nil, err
+	}
+	return deleteResponse, nil
+}
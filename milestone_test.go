@@ -0,0 +1,128 @@
+package teamwork_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/swill/teamwork"
+	"github.com/swill/teamwork/teamworktest"
+)
+
+func ExampleConnection_CreateMilestone() {
+	// a fake TeamWork with a project to add the milestone to
+	server := teamworktest.NewServer()
+	defer server.Close()
+	server.AddProjects(teamwork.Project{ID: "1", Name: "Website"})
+
+	// setup the teamwork connection
+	conn, err := server.Connect()
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// create a task list and a milestone for it
+	taskList, err := conn.CreateTaskList("1", &teamwork.CreateTaskListOps{Name: "Launch"})
+	if err != nil {
+		fmt.Printf("Error creating Task List: %s", err.Error())
+		os.Exit(1)
+	}
+	createResponse, err := conn.CreateMilestone("1", &teamwork.CreateMilestoneOps{
+		Title:               "Go Live",
+		Deadline:            "20301001",
+		ResponsiblePartyIDs: "1",
+		TaskListIDs:         taskList.ID.String(),
+	})
+	if err != nil {
+		fmt.Printf("Error creating Milestone: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// the task lists include their milestone when asked for
+	taskLists, _, err := conn.GetProjectTaskLists("1", &teamwork.GetProjectTaskListsOps{ShowMilestones: "1"})
+	if err != nil {
+		fmt.Printf("Error getting Task Lists: %s", err.Error())
+	}
+	for _, taskList := range taskLists {
+		fmt.Println(taskList.Name, "Milestone:", taskList.Milestone.Title, taskList.Milestone.Deadline)
+	}
+
+	// complete it
+	if _, err = conn.CompleteMilestone(createResponse.ID.String()); err != nil {
+		fmt.Printf("Error completing Milestone: %s", err.Error())
+	}
+	milestones, _, err := conn.GetProjectMilestones("1", &teamwork.GetMilestonesOps{Find: "completed"})
+	if err != nil {
+		fmt.Printf("Error getting Milestones: %s", err.Error())
+	}
+	fmt.Println("Completed:", len(milestones))
+	// Output:
+	// Launch Milestone: Go Live 20301001
+	// Completed: 1
+}
+
+func TestMilestoneRequests(t *testing.T) {
+	conn := connectTo(t,
+		apiCall{
+			method:   "POST",
+			path:     "/projects/158721/milestones.json",
+			body:     `{"milestone": {"title": "Go Live", "deadline": "20301001", "responsible-party-ids": "85457,85458", "notify": true, "tasklistIds": "704748"}}`,
+			response: `{"milestoneId": "512345", "STATUS": "OK"}`,
+		},
+		apiCall{
+			method: "PUT",
+			path:   "/milestones/512345.json",
+			body:   `{"milestone": {"deadline": "20301015", "move-upcoming-milestones": true}}`,
+		},
+		apiCall{method: "PUT", path: "/milestones/512345/complete.json"},
+		apiCall{method: "PUT", path: "/milestones/512345/uncomplete.json"},
+		apiCall{method: "DELETE", path: "/milestones/512345.json"},
+	)
+
+	notify := true
+	createResponse, err := conn.CreateMilestone("158721", &teamwork.CreateMilestoneOps{
+		Title:               "Go Live",
+		Deadline:            "20301001",
+		ResponsiblePartyIDs: "85457,85458",
+		Notify:              &notify,
+		TaskListIDs:         "704748",
+	})
+	if err != nil || createResponse.ID != "512345" {
+		t.Fatalf("CreateMilestone() = %+v, %v, want 512345", createResponse, err)
+	}
+	moveUpcoming := true
+	_, err = conn.UpdateMilestone("512345", &teamwork.UpdateMilestoneOps{Deadline: "20301015", MoveUpcomingMilestones: &moveUpcoming})
+	if err != nil {
+		t.Errorf("UpdateMilestone() error = %v", err)
+	}
+	if _, err := conn.CompleteMilestone("512345"); err != nil {
+		t.Errorf("CompleteMilestone() error = %v", err)
+	}
+	if _, err := conn.UncompleteMilestone("512345"); err != nil {
+		t.Errorf("UncompleteMilestone() error = %v", err)
+	}
+	if _, err := conn.DeleteMilestone("512345"); err != nil {
+		t.Errorf("DeleteMilestone() error = %v", err)
+	}
+}
+
+func TestGetAllMilestones(t *testing.T) {
+	conn := connectToPages(t, "/milestones.json", "milestones", 5)
+	milestones, err := conn.GetAllMilestones(&teamwork.GetMilestonesOps{Find: "upcoming"})
+	if err != nil {
+		t.Fatalf("GetAllMilestones() error = %v", err)
+	}
+	if got := pageIDs(milestones, func(m teamwork.Milestone) teamwork.ID { return m.ID }); got != "1,2,3,4,5" {
+		t.Errorf("GetAllMilestones() IDs = %s, want 1,2,3,4,5", got)
+	}
+
+	conn = connectToPages(t, "/projects/158721/milestones.json", "milestones", 3)
+	milestones, err = conn.GetAllProjectMilestones("158721", nil)
+	if err != nil {
+		t.Fatalf("GetAllProjectMilestones() error = %v", err)
+	}
+	if got := pageIDs(milestones, func(m teamwork.Milestone) teamwork.ID { return m.ID }); got != "1,2,3" {
+		t.Errorf("GetAllProjectMilestones() IDs = %s, want 1,2,3", got)
+	}
+}
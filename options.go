@@ -0,0 +1,120 @@
+package teamwork
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Option configures a Connection.  Options are passed to Connect
+// and are applied before the connection authenticates.
+type Option func(*Connection)
+
+// WithHTTPClient sets the http.Client used for every call to the API.
+// Use this to configure proxies, custom TLS roots or a shared transport.
+// Default: a new http.Client{}
+func WithHTTPClient(client *http.Client) Option {
+	return func(conn *Connection) {
+		if client != nil {
+			conn.client = client
+		}
+	}
+}
+
+// WithTimeout sets the timeout applied to every call to the API.
+// The client passed with WithHTTPClient is copied, not modified.
+// Default: no timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(conn *Connection) {
+		conn.timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every call to the API.
+func WithUserAgent(userAgent string) Option {
+	return func(conn *Connection) {
+		conn.userAgent = userAgent
+	}
+}
+
+// WithBaseURL overrides the URL used for the API calls made after
+// authenticating.  By default the URL returned by TeamWork for the
+// authenticated Account is used.
+func WithBaseURL(baseURL string) Option {
+	return func(conn *Connection) {
+		if baseURL != "" && !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		conn.baseURL = baseURL
+	}
+}
+
+// WithRetries sets how many times an idempotent call is retried when
+// TeamWork responds with a 429 or 5xx status code.  Use 0 to disable retries.
+// Default: 3
+func WithRetries(maxRetries int) Option {
+	return func(conn *Connection) {
+		if maxRetries >= 0 {
+			conn.maxRetries = maxRetries
+		}
+	}
+}
+
+// WithBackoff sets the bounds of the exponential backoff between retries.
+// A Retry-After header sent by TeamWork takes precedence, but no wait is
+// longer than max.
+// Default: 1 second to 30 seconds
+func WithBackoff(min, max time.Duration) Option {
+	return func(conn *Connection) {
+		if min > 0 && max >= min {
+			conn.minBackoff = min
+			conn.maxBackoff = max
+		}
+	}
+}
+
+// WithRateLimit limits the calls made through the Connection to perMinute
+// calls per minute, with bursts of up to burst calls.
+// Default: no limit
+func WithRateLimit(perMinute, burst int) Option {
+	return func(conn *Connection) {
+		conn.limiter = NewLimiter(perMinute, burst)
+	}
+}
+
+// WithLimiter shares a Limiter with the Connection.  Pass the same Limiter
+// to every Connection to an account to keep them all under its quota.
+func WithLimiter(limiter *Limiter) Option {
+	return func(conn *Connection) {
+		conn.limiter = limiter
+	}
+}
+
+// WithPageConcurrency sets how many pages the GetAll* calls fetch in
+// parallel once the first page reveals the number of pages.  The pages
+// still wait on the rate limit of the Connection.
+// Default: 1 (one page at a time)
+func WithPageConcurrency(workers int) Option {
+	return func(conn *Connection) {
+		conn.pageWorkers = workers
+	}
+}
+
+// WithLogger sets the logger used to report each call to the API.
+// Calls are logged at the debug level and failures at the error level.
+// Default: nothing is logged
+func WithLogger(logger *slog.Logger) Option {
+	return func(conn *Connection) {
+		conn.logger = logger
+	}
+}
+
+// WithDebug logs a dump of every request and response at the debug level,
+// with the API token redacted.  If no logger is set with WithLogger,
+// the dumps are written to stderr.
+func WithDebug(debug bool) Option {
+	return func(conn *Connection) {
+		conn.debug = debug
+	}
+}
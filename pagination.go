@@ -0,0 +1,130 @@
+package teamwork
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// pageFunc fetches a single page of a list from TeamWork.
+type pageFunc[T any] func(ctx context.Context, page int) ([]T, Pages, error)
+
+// pager walks the pages of a list from TeamWork, starting at the page start
+// and following the X-Page(s) headers until the last page.
+type pager[T any] struct {
+	start int
+	fetch pageFunc[T]
+}
+
+// all returns an iterator over every item of the list, fetching each page
+// as it is needed.  Iteration stops early when the consumer stops, an error
+// is returned or ctx is done.
+func (p pager[T]) all(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for page := p.start; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			items, pages, err := p.fetch(ctx, page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) == 0 || page >= pages.Pages {
+				return
+			}
+		}
+	}
+}
+
+// collect gathers every item of the list.  With more than one worker, the
+// first page is fetched to learn the number of pages and the remaining pages
+// are fetched in parallel by that many workers.  Items are returned in page
+// order.  On error, the items of the pages before the failure are returned.
+func (p pager[T]) collect(ctx context.Context, workers int) ([]T, error) {
+	items := make([]T, 0)
+	if workers < 2 {
+		for item, err := range p.all(ctx) {
+			if err != nil {
+				return items, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+
+	first, pages, err := p.fetch(ctx, p.start)
+	if err != nil {
+		return items, err
+	}
+	items = append(items, first...)
+	if len(first) == 0 || p.start >= pages.Pages {
+		return items, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		results  = make([][]T, pages.Pages-p.start)
+		fetched  = make([]bool, len(results))
+		pageCh   = make(chan int)
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for i := 0; i < min(workers, len(results)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range pageCh {
+				pageItems, _, err := p.fetch(ctx, page)
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				results[page-p.start-1] = pageItems
+				fetched[page-p.start-1] = true
+			}
+		}()
+	}
+feed:
+	for page := p.start + 1; page <= pages.Pages; page++ {
+		select {
+		case pageCh <- page:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(pageCh)
+	wg.Wait()
+
+	for i := range results {
+		if !fetched[i] {
+			break
+		}
+		items = append(items, results[i]...)
+	}
+	if firstErr != nil {
+		return items, firstErr
+	}
+	return items, ctx.Err()
+}
+
+// startPage is the page to start paginating from.
+func startPage(page *int) int {
+	if page != nil && *page > 0 {
+		return *page
+	}
+	return 1
+}
@@ -1,8 +1,10 @@
 package teamwork
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -20,80 +22,56 @@ type Person struct {
 		State       string `json:"state"`
 		Zipcode     string `json:"zipcode"`
 	} `json:"address"`
-	AddressCity    string `json:"address-city"`
-	AddressCountry string `json:"address-country"`
-	AddressLine1   string `json:"address-line-1"`
-	AddressLine2   string `json:"address-line-2"`
-	AddressState   string `json:"address-state"`
-	AddressZip     string `json:"address-zip"`
-	Administrator  bool   `json:"administrator"`
-	AvatarURL      string `json:"avatar-url"`
+	AddressCity    string   `json:"address-city"`
+	AddressCountry string   `json:"address-country"`
+	AddressLine1   string   `json:"address-line-1"`
+	AddressLine2   string   `json:"address-line-2"`
+	AddressState   string   `json:"address-state"`
+	AddressZip     string   `json:"address-zip"`
+	Administrator  FlexBool `json:"administrator"`
+	AvatarURL      string   `json:"avatar-url"`
 	//CompanyID              string    `json:"company-id"`
-	CompanyID               string    `json:"companyId"`
+	CompanyID               ID        `json:"companyId"`
 	CompanyName             string    `json:"company-name"`
 	CreatedAt               time.Time `json:"created-at"`
-	Deleted                 bool      `json:"deleted"`
-	DocumentEditorInstalled bool      `json:"documentEditorInstalled"` // Person only
+	Deleted                 FlexBool  `json:"deleted"`
+	DocumentEditorInstalled FlexBool  `json:"documentEditorInstalled"` // Person only
 	EmailAddress            string    `json:"email-address"`
 	EmailAlt1               string    `json:"email-alt-1"`
 	EmailAlt2               string    `json:"email-alt-2"`
 	EmailAlt3               string    `json:"email-alt-3"`
 	FirstName               string    `json:"first-name"`
-	HasAccessToNewProjects  bool      `json:"has-access-to-new-projects"`
-	HasDeskAccount          bool      `json:"has-desk-account"` // Person only
-	ID                      string    `json:"id"`
+	HasAccessToNewProjects  FlexBool  `json:"has-access-to-new-projects"`
+	HasDeskAccount          FlexBool  `json:"has-desk-account"` // Person only
+	ID                      ID        `json:"id"`
 	ImHandle                string    `json:"im-handle"`
 	ImService               string    `json:"im-service"`
-	// bool in People and string in Person :(
-	//InOwnerCompany          bool      `json:"in-owner-company"`
-	IsClockedIn bool   `json:"isClockedIn"` // Person only
-	LastActive  string `json:"last-active"`
-	//LastChangedOn time.Time `json:"last-changed-on"` // fails if empty ""
-	//LastLogin              time.Time `json:"last-login"` // fails if empty ""
-	LastName     string `json:"last-name"`
-	Localization struct {
-		DateFormat            string `json:"dateFormat"`
-		DateFormatID          string `json:"dateFormatId"`
-		Language              string `json:"language"`
-		LanguageCode          string `json:"languageCode"`
-		StartOnSunday         bool   `json:"start-on-sunday"`
-		TimeFormat            string `json:"timeFormat"`
-		TimeFormatID          string `json:"timeFormatId"`
-		Timezone              string `json:"timezone"`
-		TimezoneID            string `json:"timezoneId"`
-		TimezoneJavaRefCode   string `json:"timezoneJavaRefCode"`
-		TimezoneUTCOffsetMins string `json:"timezoneUTCOffsetMins"`
+	InOwnerCompany          FlexBool  `json:"in-owner-company"`
+	IsClockedIn             FlexBool  `json:"isClockedIn"` // Person only
+	LastActive              string    `json:"last-active"`
+	LastChangedOn           Time      `json:"last-changed-on"`
+	LastLogin               Time      `json:"last-login"`
+	LastName                string    `json:"last-name"`
+	Localization            struct {
+		DateFormat            string   `json:"dateFormat"`
+		DateFormatID          ID       `json:"dateFormatId"`
+		Language              string   `json:"language"`
+		LanguageCode          string   `json:"languageCode"`
+		StartOnSunday         FlexBool `json:"start-on-sunday"`
+		TimeFormat            string   `json:"timeFormat"`
+		TimeFormatID          ID       `json:"timeFormatId"`
+		Timezone              string   `json:"timezone"`
+		TimezoneID            ID       `json:"timezoneId"`
+		TimezoneJavaRefCode   string   `json:"timezoneJavaRefCode"`
+		TimezoneUTCOffsetMins FlexInt  `json:"timezoneUTCOffsetMins"`
 	} `json:"localization"`
-	LoginCount  string `json:"login-count"`
-	Notes       string `json:"notes"`
-	OpenID      string `json:"openId"`
+	LoginCount  FlexInt `json:"login-count"`
+	Notes       string  `json:"notes"`
+	OpenID      string  `json:"openId"`
 	Permissions struct {
-		AddFiles                          string `json:"add-files"`
-		AddLinks                          string `json:"add-links"`
-		AddMessages                       string `json:"add-messages"`
-		AddMilestones                     string `json:"add-milestones"`
-		AddNotebooks                      string `json:"add-notebooks"`
-		AddPeopleToProject                string `json:"add-people-to-project"`
-		AddTaskLists                      string `json:"add-taskLists"`
-		AddTasks                          string `json:"add-tasks"`
-		AddTime                           string `json:"add-time"`
-		CanAddProjects                    bool   `json:"can-add-projects"`
-		CanBeAssignedToTasksAndMilestones string `json:"can-be-assigned-to-tasks-and-milestones"`
-		CanManagePeople                   bool   `json:"can-manage-people"`
-		CanReceiveEmail                   string `json:"can-receive-email"`
-		EditAllTasks                      string `json:"edit-all-tasks"`
-		IsObserving                       string `json:"is-observing"`
-		ProjectAdministrator              string `json:"project-administrator"`
-		SetPrivacy                        string `json:"set-privacy"`
-		ViewAllTimeLogs                   string `json:"view-all-time-logs"`
-		ViewEstimatedTime                 string `json:"view-estimated-time"`
-		ViewInvoices                      string `json:"view-invoices"`
-		ViewLinks                         string `json:"view-links"`
-		ViewMessagesAndFiles              string `json:"view-messages-and-files"`
-		ViewNotebooks                     string `json:"view-notebooks"`
-		ViewRiskRegister                  string `json:"view-risk-register"`
-		ViewTasksAndMilestones            string `json:"view-tasks-and-milestones"`
-		ViewTime                          string `json:"view-time"`
+		ProjectPermissions
+		CanAddProjects  FlexBool `json:"can-add-projects"`
+		CanManagePeople FlexBool `json:"can-manage-people"`
 	} `json:"permissions"`
 	PhoneNumberFax         string `json:"phone-number-fax"`
 	PhoneNumberHome        string `json:"phone-number-home"`
@@ -110,8 +88,8 @@ type Person struct {
 	PrivateNotesText      string        `json:"private-notes-text"`
 	Profile               string        `json:"profile"`      // Person Only
 	ProfileText           string        `json:"profile-text"` // Person Only
-	Projects              []string      `json:"projects"`
-	SiteOwner             bool          `json:"site-owner"`
+	Projects              []ID          `json:"projects"`
+	SiteOwner             FlexBool      `json:"site-owner"`
 	Tags                  []interface{} `json:"tags"`
 	TextFormat            string        `json:"textFormat"`
 	Title                 string        `json:"title"`
@@ -119,10 +97,10 @@ type Person struct {
 	UserName              string        `json:"user-name"`
 	UserType              string        `json:"user-type"`
 	UserUUID              string        `json:"userUUID"`
-	UseShorthandDurations bool          `json:"useShorthandDurations"`
-	UserInvited           string        `json:"user-invited"`
-	//UserInvitedDate       time.Time     `json:"user-invited-date"` // fails if empty ""
-	UserInvitedStatus string `json:"user-invited-status"`
+	UseShorthandDurations FlexBool      `json:"useShorthandDurations"`
+	UserInvited           FlexBool      `json:"user-invited"`
+	UserInvitedDate       Time          `json:"user-invited-date"`
+	UserInvitedStatus     string        `json:"user-invited-status"`
 }
 
 // GetPeopleOps is used to generate the query params for the
@@ -150,19 +128,24 @@ type GetPeopleOps struct {
 //
 // ref: http://developer.teamwork.com/people#get_people
 func (conn *Connection) GetPeople(ops *GetPeopleOps) (People, Pages, error) {
+	return conn.GetPeopleContext(context.Background(), ops)
+}
+
+// GetPeopleContext is like GetPeople but uses ctx for the request.
+func (conn *Connection) GetPeopleContext(ctx context.Context, ops *GetPeopleOps) (People, Pages, error) {
 	people := make(People, 0)
 	pages := &Pages{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%speople.json%s", conn.Account.Url, params)
-	reader, headers, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%speople.json%s", conn.baseURL, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return people, *pages, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
-	getHeaders(headers, pages)
 	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return people, *pages, err
+	}
 
 	err = json.NewDecoder(reader).Decode(&struct {
 		*People `json:"people"`
@@ -174,24 +157,63 @@ func (conn *Connection) GetPeople(ops *GetPeopleOps) (People, Pages, error) {
 	return people, *pages, nil
 }
 
+// AllPeople returns an iterator over all the people according to the
+// specified GetPeopleOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllPeople(ctx context.Context, ops *GetPeopleOps) iter.Seq2[Person, error] {
+	return conn.peoplePager(ops).all(ctx)
+}
+
+// peoplePager fetches the pages for AllPeople and GetAllPeople.
+func (conn *Connection) peoplePager(ops *GetPeopleOps) pager[Person] {
+	pageOps := GetPeopleOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Person]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Person, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetPeopleContext(ctx, &ops)
+		},
+	}
+}
+
+// GetAllPeople gets all the people according to the specified
+// GetPeopleOps, walking every page.
+func (conn *Connection) GetAllPeople(ops *GetPeopleOps) (People, error) {
+	return conn.GetAllPeopleContext(context.Background(), ops)
+}
+
+// GetAllPeopleContext is like GetAllPeople but uses ctx for the requests.
+func (conn *Connection) GetAllPeopleContext(ctx context.Context, ops *GetPeopleOps) (People, error) {
+	return conn.peoplePager(ops).collect(ctx, conn.pageWorkers)
+}
+
 // GetProjectPeople gets project people available according to the specified
 // GetPeopleOps and company id passed in.
 //
 // ref: http://developer.teamwork.com/people#get_all_people_(w
 func (conn *Connection) GetProjectPeople(id string, ops *GetPeopleOps) (People, Pages, error) {
+	return conn.GetProjectPeopleContext(context.Background(), id, ops)
+}
+
+// GetProjectPeopleContext is like GetProjectPeople but uses ctx for the request.
+func (conn *Connection) GetProjectPeopleContext(ctx context.Context, id string, ops *GetPeopleOps) (People, Pages, error) {
 	people := make(People, 0)
 	pages := &Pages{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%sprojects/%s/people.json%s", conn.Account.Url, id, params)
-	reader, headers, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%sprojects/%s/people.json%s", conn.baseURL, id, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return people, *pages, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
-	getHeaders(headers, pages)
 	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return people, *pages, err
+	}
 
 	err = json.NewDecoder(reader).Decode(&struct {
 		*People `json:"people"`
@@ -203,24 +225,63 @@ func (conn *Connection) GetProjectPeople(id string, ops *GetPeopleOps) (People,
 	return people, *pages, nil
 }
 
+// AllProjectPeople returns an iterator over all the project people according to the
+// specified GetPeopleOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllProjectPeople(ctx context.Context, id string, ops *GetPeopleOps) iter.Seq2[Person, error] {
+	return conn.projectPeoplePager(id, ops).all(ctx)
+}
+
+// projectPeoplePager fetches the pages for AllProjectPeople and GetAllProjectPeople.
+func (conn *Connection) projectPeoplePager(id string, ops *GetPeopleOps) pager[Person] {
+	pageOps := GetPeopleOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Person]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Person, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetProjectPeopleContext(ctx, id, &ops)
+		},
+	}
+}
+
+// GetAllProjectPeople gets all the project people according to the specified
+// GetPeopleOps, walking every page.
+func (conn *Connection) GetAllProjectPeople(id string, ops *GetPeopleOps) (People, error) {
+	return conn.GetAllProjectPeopleContext(context.Background(), id, ops)
+}
+
+// GetAllProjectPeopleContext is like GetAllProjectPeople but uses ctx for the requests.
+func (conn *Connection) GetAllProjectPeopleContext(ctx context.Context, id string, ops *GetPeopleOps) (People, error) {
+	return conn.projectPeoplePager(id, ops).collect(ctx, conn.pageWorkers)
+}
+
 // GetCompanyPeople gets company people available according to the specified
 // GetPeopleOps and company id passed in.
 //
 // ref: http://developer.teamwork.com/people#get_people_(withi
 func (conn *Connection) GetCompanyPeople(id string, ops *GetPeopleOps) (People, Pages, error) {
+	return conn.GetCompanyPeopleContext(context.Background(), id, ops)
+}
+
+// GetCompanyPeopleContext is like GetCompanyPeople but uses ctx for the request.
+func (conn *Connection) GetCompanyPeopleContext(ctx context.Context, id string, ops *GetPeopleOps) (People, Pages, error) {
 	people := make(People, 0)
 	pages := &Pages{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%scompanies/%s/people.json%s", conn.Account.Url, id, params)
-	reader, headers, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%scompanies/%s/people.json%s", conn.baseURL, id, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return people, *pages, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
-	getHeaders(headers, pages)
 	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return people, *pages, err
+	}
 
 	err = json.NewDecoder(reader).Decode(&struct {
 		*People `json:"people"`
@@ -232,19 +293,56 @@ func (conn *Connection) GetCompanyPeople(id string, ops *GetPeopleOps) (People,
 	return people, *pages, nil
 }
 
+// AllCompanyPeople returns an iterator over all the company people according to the
+// specified GetPeopleOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllCompanyPeople(ctx context.Context, id string, ops *GetPeopleOps) iter.Seq2[Person, error] {
+	return conn.companyPeoplePager(id, ops).all(ctx)
+}
+
+// companyPeoplePager fetches the pages for AllCompanyPeople and GetAllCompanyPeople.
+func (conn *Connection) companyPeoplePager(id string, ops *GetPeopleOps) pager[Person] {
+	pageOps := GetPeopleOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Person]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Person, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetCompanyPeopleContext(ctx, id, &ops)
+		},
+	}
+}
+
+// GetAllCompanyPeople gets all the company people according to the specified
+// GetPeopleOps, walking every page.
+func (conn *Connection) GetAllCompanyPeople(id string, ops *GetPeopleOps) (People, error) {
+	return conn.GetAllCompanyPeopleContext(context.Background(), id, ops)
+}
+
+// GetAllCompanyPeopleContext is like GetAllCompanyPeople but uses ctx for the requests.
+func (conn *Connection) GetAllCompanyPeopleContext(ctx context.Context, id string, ops *GetPeopleOps) (People, error) {
+	return conn.companyPeoplePager(id, ops).collect(ctx, conn.pageWorkers)
+}
+
 // GetPerson gets a single person based on a person ID.
 //
 // ref: http://developer.teamwork.com/projectsapi#retrieve_a_single
 func (conn *Connection) GetPerson(id string) (Person, error) {
+	return conn.GetPersonContext(context.Background(), id)
+}
+
+// GetPersonContext is like GetPerson but uses ctx for the request.
+func (conn *Connection) GetPersonContext(ctx context.Context, id string) (Person, error) {
 	person := &Person{}
 	method := "GET"
-	url := fmt.Sprintf("%speople/%s.json", conn.Account.Url, id)
-	reader, _, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%speople/%s.json", conn.baseURL, id)
+	reader, _, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return *person, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
 	defer reader.Close()
 
 	err = json.NewDecoder(reader).Decode(&struct {
@@ -259,15 +357,18 @@ func (conn *Connection) GetPerson(id string) (Person, error) {
 
 // GetCurrentPerson gets the current person
 func (conn *Connection) GetCurrentPerson() (Person, error) {
+	return conn.GetCurrentPersonContext(context.Background())
+}
+
+// GetCurrentPersonContext is like GetCurrentPerson but uses ctx for the request.
+func (conn *Connection) GetCurrentPersonContext(ctx context.Context) (Person, error) {
 	person := &Person{}
 	method := "GET"
-	url := fmt.Sprintf("%sme.json", conn.Account.Url)
-	reader, _, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%sme.json", conn.baseURL)
+	reader, _, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return *person, err
 	}
-	// data, _ := ioutil.ReadAll(reader)
-	// fmt.Printf(string(data))
 	defer reader.Close()
 
 	err = json.NewDecoder(reader).Decode(&struct {
@@ -279,3 +380,203 @@ func (conn *Connection) GetCurrentPerson() (Person, error) {
 
 	return *person, nil
 }
+
+// CreatePersonOps is used to generate the body for the
+// CreatePerson and InvitePerson API calls.
+type CreatePersonOps struct {
+	// The first name of the person.
+	FirstName string `json:"first-name"`
+	// The last name of the person.
+	LastName string `json:"last-name"`
+	// The email address the person logs in with.
+	EmailAddress string `json:"email-address"`
+	// The user name of the person.
+	// Default: the email address
+	UserName string `json:"user-name,omitempty"`
+	// The password of the person.  Leave it empty to have the person set it.
+	Password string `json:"password,omitempty"`
+	// The ID of the company the person belongs to.
+	CompanyID string `json:"company-id,omitempty"`
+	// The job title of the person.
+	Title string `json:"title,omitempty"`
+	// Valid Input: "account", "collaborator", "contact"
+	// Default: "account"
+	UserType string `json:"user-type,omitempty"`
+	// Make the person an administrator of the site.
+	// Valid Input: true, false
+	Administrator *bool `json:"administrator,omitempty"`
+	// Valid Input: true, false
+	CanAddProjects *bool `json:"canAddProjects,omitempty"`
+	// Give the person access to all new projects.
+	// Valid Input: true, false
+	AutoGiveProjectAccess *bool `json:"autoGiveProjectAccess,omitempty"`
+	// Email the person an invitation to log in.
+	// Valid Input: true, false
+	SendWelcomeEmail *bool `json:"sendWelcomeEmail,omitempty"`
+	// A message to add to the invitation.
+	WelcomeEmailMessage string `json:"welcomeEmailMessage,omitempty"`
+	// Contact details.
+	PhoneNumberOffice    string `json:"phone-number-office,omitempty"`
+	PhoneNumberOfficeExt string `json:"phone-number-office-ext,omitempty"`
+	PhoneNumberMobile    string `json:"phone-number-mobile,omitempty"`
+	PhoneNumberHome      string `json:"phone-number-home,omitempty"`
+	PhoneNumberFax       string `json:"phone-number-fax,omitempty"`
+	ImHandle             string `json:"im-handle,omitempty"`
+	ImService            string `json:"im-service,omitempty"`
+	Notes                string `json:"notes,omitempty"`
+	PrivateNotes         string `json:"private-notes,omitempty"`
+	// Localization.
+	//
+	// The language code of the person (eg: "EN").
+	LanguageCode string `json:"userLanguage,omitempty"`
+	DateFormatID string `json:"dateFormatId,omitempty"`
+	TimeFormatID string `json:"timeFormatId,omitempty"`
+	TimezoneID   string `json:"timezoneId,omitempty"`
+	// Valid Input: true, false
+	StartOnSunday *bool `json:"start-on-sunday,omitempty"`
+}
+
+// UpdatePersonOps is used to generate the body for the
+// UpdatePerson API call.  Only the fields which are set are updated.
+type UpdatePersonOps struct {
+	// The first name of the person.
+	FirstName string `json:"first-name,omitempty"`
+	// The last name of the person.
+	LastName string `json:"last-name,omitempty"`
+	// The email address the person logs in with.
+	EmailAddress string `json:"email-address,omitempty"`
+	// The user name of the person.
+	UserName string `json:"user-name,omitempty"`
+	// The password of the person.
+	Password string `json:"password,omitempty"`
+	// The ID of the company the person belongs to.
+	CompanyID string `json:"company-id,omitempty"`
+	// The job title of the person.
+	Title string `json:"title,omitempty"`
+	// Valid Input: "account", "collaborator", "contact"
+	UserType string `json:"user-type,omitempty"`
+	// Make the person an administrator of the site.
+	// Valid Input: true, false
+	Administrator *bool `json:"administrator,omitempty"`
+	// Valid Input: true, false
+	CanAddProjects *bool `json:"canAddProjects,omitempty"`
+	// Give the person access to all new projects.
+	// Valid Input: true, false
+	AutoGiveProjectAccess *bool `json:"autoGiveProjectAccess,omitempty"`
+	// Contact details.
+	PhoneNumberOffice    string `json:"phone-number-office,omitempty"`
+	PhoneNumberOfficeExt string `json:"phone-number-office-ext,omitempty"`
+	PhoneNumberMobile    string `json:"phone-number-mobile,omitempty"`
+	PhoneNumberHome      string `json:"phone-number-home,omitempty"`
+	PhoneNumberFax       string `json:"phone-number-fax,omitempty"`
+	ImHandle             string `json:"im-handle,omitempty"`
+	ImService            string `json:"im-service,omitempty"`
+	Notes                string `json:"notes,omitempty"`
+	PrivateNotes         string `json:"private-notes,omitempty"`
+	// Localization.
+	//
+	// The language code of the person (eg: "EN").
+	LanguageCode string `json:"userLanguage,omitempty"`
+	DateFormatID string `json:"dateFormatId,omitempty"`
+	TimeFormatID string `json:"timeFormatId,omitempty"`
+	TimezoneID   string `json:"timezoneId,omitempty"`
+	// Valid Input: true, false
+	StartOnSunday *bool `json:"start-on-sunday,omitempty"`
+}
+
+// CreatePersonResponse captures the response returned from a create person action
+type CreatePersonResponse struct {
+	ID     ID     `json:"id"`
+	Status string `json:"STATUS"`
+}
+
+// CreatePerson adds a person according to the specified
+// CreatePersonOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/people/post-people-json
+func (conn *Connection) CreatePerson(ops *CreatePersonOps) (*CreatePersonResponse, error) {
+	return conn.CreatePersonContext(context.Background(), ops)
+}
+
+// CreatePersonContext is like CreatePerson but uses ctx for the request.
+func (conn *Connection) CreatePersonContext(ctx context.Context, ops *CreatePersonOps) (*CreatePersonResponse, error) {
+	createResponse := &CreatePersonResponse{}
+	url := fmt.Sprintf("%speople.json", conn.baseURL)
+	err := conn.sendJSON(ctx, "POST", url, struct {
+		Person *CreatePersonOps `json:"person"`
+	}{ops}, createResponse)
+	if err != nil {
+		return nil, err
+	}
+	return createResponse, nil
+}
+
+// InvitePerson adds a person like CreatePerson and emails them an
+// invitation to log in, with the WelcomeEmailMessage if there is one.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/people/post-people-json
+func (conn *Connection) InvitePerson(ops *CreatePersonOps) (*CreatePersonResponse, error) {
+	return conn.InvitePersonContext(context.Background(), ops)
+}
+
+// InvitePersonContext is like InvitePerson but uses ctx for the request.
+func (conn *Connection) InvitePersonContext(ctx context.Context, ops *CreatePersonOps) (*CreatePersonResponse, error) {
+	inviteOps := CreatePersonOps{}
+	if ops != nil {
+		inviteOps = *ops
+	}
+	sendWelcomeEmail := true
+	inviteOps.SendWelcomeEmail = &sendWelcomeEmail
+	return conn.CreatePersonContext(ctx, &inviteOps)
+}
+
+// UpdatePerson updates a person according to the specified
+// UpdatePersonOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/people/put-people-id-json
+func (conn *Connection) UpdatePerson(id string, ops *UpdatePersonOps) (*StatusResponse, error) {
+	return conn.UpdatePersonContext(context.Background(), id, ops)
+}
+
+// UpdatePersonContext is like UpdatePerson but uses ctx for the request.
+func (conn *Connection) UpdatePersonContext(ctx context.Context, id string, ops *UpdatePersonOps) (*StatusResponse, error) {
+	updateResponse := &StatusResponse{}
+	url := fmt.Sprintf("%speople/%s.json", conn.baseURL, id)
+	err := conn.sendJSON(ctx, "PUT", url, struct {
+		Person *UpdatePersonOps `json:"person"`
+	}{ops}, updateResponse)
+	if err != nil {
+		return nil, err
+	}
+	return updateResponse, nil
+}
+
+// SetPersonAdministrator makes a person an administrator of the site, or
+// takes it away.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/people/put-people-id-json
+func (conn *Connection) SetPersonAdministrator(id string, administrator bool) (*StatusResponse, error) {
+	return conn.SetPersonAdministratorContext(context.Background(), id, administrator)
+}
+
+// SetPersonAdministratorContext is like SetPersonAdministrator but uses ctx for the request.
+func (conn *Connection) SetPersonAdministratorContext(ctx context.Context, id string, administrator bool) (*StatusResponse, error) {
+	return conn.UpdatePersonContext(ctx, id, &UpdatePersonOps{Administrator: &administrator})
+}
+
+// DeletePerson deletes a person.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/people/delete-people-id-json
+func (conn *Connection) DeletePerson(id string) (*StatusResponse, error) {
+	return conn.DeletePersonContext(context.Background(), id)
+}
+
+// DeletePersonContext is like DeletePerson but uses ctx for the request.
+func (conn *Connection) DeletePersonContext(ctx context.Context, id string) (*StatusResponse, error) {
+	deleteResponse := &StatusResponse{}
+	url := fmt.Sprintf("%speople/%s.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "DELETE", url, nil, deleteResponse); err != nil {
+		return nil, err
+	}
+	return deleteResponse, nil
+}
@@ -3,15 +3,17 @@ package teamwork_test
 import (
 	"fmt"
 	"os"
+	"testing"
 
 	"github.com/swill/teamwork"
+	"github.com/swill/teamwork/teamworktest"
 )
 
 func ExampleConnection_GetPeople() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetPeople")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -33,13 +35,20 @@ func ExampleConnection_GetPeople() {
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
 	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetPeople
+	// 1. Username: jdoe
+	// 1. Full Name: Jane Doe
+	// on page #: 1
+	// # of pages: 1
+	// # of records: 2
 }
 
 func ExampleConnection_GetProjectPeople() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetProjectPeople")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -61,13 +70,20 @@ func ExampleConnection_GetProjectPeople() {
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
 	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetProjectPeople
+	// 1. Username: jdoe
+	// 1. Full Name: Jane Doe
+	// on page #: 1
+	// # of pages: 1
+	// # of records: 1
 }
 
 func ExampleConnection_GetCompanyPeople() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetCompanyPeople")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -89,13 +105,20 @@ func ExampleConnection_GetCompanyPeople() {
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
 	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetCompanyPeople
+	// 1. Username: jdoe
+	// 1. Full Name: Jane Doe
+	// on page #: 1
+	// # of pages: 1
+	// # of records: 2
 }
 
 func ExampleConnection_GetPerson() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetPerson")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -110,4 +133,113 @@ func ExampleConnection_GetPerson() {
 	fmt.Println("GetPerson")
 	fmt.Println("Username:", person.UserName)
 	fmt.Println("Full Name:", person.FirstName, person.LastName)
+	// Output:
+	// GetPerson
+	// Username: jdoe
+	// Full Name: Jane Doe
+}
+
+func ExampleConnection_InvitePerson() {
+	// a fake TeamWork with one person in it
+	server := teamworktest.NewServer()
+	defer server.Close()
+	server.AddPeople(teamwork.Person{ID: "85457", FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"})
+
+	// setup the teamwork connection
+	conn, err := server.Connect()
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// invite a new starter
+	inviteResponse, err := conn.InvitePerson(&teamwork.CreatePersonOps{
+		FirstName:    "Sam",
+		LastName:     "Lee",
+		EmailAddress: "sam@example.com",
+		CompanyID:    "12345",
+		UserType:     "account",
+		TimezoneID:   "27",
+	})
+	if err != nil {
+		fmt.Printf("Error inviting Person: %s", err.Error())
+		os.Exit(1)
+	}
+	person, err := conn.GetPerson(inviteResponse.ID.String())
+	if err != nil {
+		fmt.Printf("Error getting Person: %s", err.Error())
+	}
+	fmt.Println(person.FirstName, person.LastName, "invited:", person.UserInvited, person.UserInvitedStatus)
+
+	// the same email address can't be added twice
+	_, err = conn.CreatePerson(&teamwork.CreatePersonOps{EmailAddress: "jane@example.com"})
+	fmt.Println("Error:", err.(*teamwork.APIError).Message)
+
+	// offboard someone who is leaving
+	if _, err = conn.SetPersonAdministrator("85457", false); err != nil {
+		fmt.Printf("Error updating Person: %s", err.Error())
+	}
+	if _, err = conn.DeletePerson("85457"); err != nil {
+		fmt.Printf("Error deleting Person: %s", err.Error())
+	}
+	people, _, err := conn.GetPeople(&teamwork.GetPeopleOps{})
+	if err != nil {
+		fmt.Printf("Error getting People: %s", err.Error())
+	}
+	fmt.Println("# of people:", len(people))
+	// Output:
+	// Sam Lee invited: true PENDING
+	// Error: A person with this email address already exists
+	// # of people: 1
+}
+
+func TestPersonRequests(t *testing.T) {
+	conn := connectTo(t,
+		apiCall{
+			method:   "POST",
+			path:     "/people.json",
+			body:     `{"person": {"first-name": "Sam", "last-name": "Lee", "email-address": "sam@example.com", "company-id": "12345", "user-type": "account", "timezoneId": "27"}}`,
+			response: `{"id": "85460", "STATUS": "OK"}`,
+		},
+		apiCall{
+			method:   "POST",
+			path:     "/people.json",
+			body:     `{"person": {"first-name": "Kim", "last-name": "Park", "email-address": "kim@example.com", "sendWelcomeEmail": true, "welcomeEmailMessage": "Welcome aboard"}}`,
+			response: `{"id": "85461", "STATUS": "OK"}`,
+		},
+		apiCall{method: "PUT", path: "/people/85460.json", body: `{"person": {"title": "Designer", "canAddProjects": false}}`},
+		apiCall{method: "PUT", path: "/people/85460.json", body: `{"person": {"administrator": true}}`},
+		apiCall{method: "DELETE", path: "/people/85461.json"},
+	)
+
+	createResponse, err := conn.CreatePerson(&teamwork.CreatePersonOps{
+		FirstName:    "Sam",
+		LastName:     "Lee",
+		EmailAddress: "sam@example.com",
+		CompanyID:    "12345",
+		UserType:     "account",
+		TimezoneID:   "27",
+	})
+	if err != nil || createResponse.ID != "85460" {
+		t.Fatalf("CreatePerson() = %+v, %v, want 85460", createResponse, err)
+	}
+	inviteResponse, err := conn.InvitePerson(&teamwork.CreatePersonOps{
+		FirstName:           "Kim",
+		LastName:            "Park",
+		EmailAddress:        "kim@example.com",
+		WelcomeEmailMessage: "Welcome aboard",
+	})
+	if err != nil || inviteResponse.ID != "85461" {
+		t.Errorf("InvitePerson() = %+v, %v, want 85461", inviteResponse, err)
+	}
+	canAddProjects := false
+	if _, err := conn.UpdatePerson("85460", &teamwork.UpdatePersonOps{Title: "Designer", CanAddProjects: &canAddProjects}); err != nil {
+		t.Errorf("UpdatePerson() error = %v", err)
+	}
+	if _, err := conn.SetPersonAdministrator("85460", true); err != nil {
+		t.Errorf("SetPersonAdministrator() error = %v", err)
+	}
+	if _, err := conn.DeletePerson("85461"); err != nil {
+		t.Errorf("DeletePerson() error = %v", err)
+	}
 }
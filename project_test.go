@@ -3,15 +3,17 @@ package teamwork_test
 import (
 	"fmt"
 	"os"
+	"testing"
 
 	"github.com/swill/teamwork"
+	"github.com/swill/teamwork/teamworktest"
 )
 
 func ExampleConnection_GetProjects() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetProjects")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -32,13 +34,20 @@ func ExampleConnection_GetProjects() {
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
 	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetProjects
+	// 1. Name: Website Redesign
+	// 1. Status: active
+	// on page #: 1
+	// # of pages: 1
+	// # of records: 2
 }
 
 func ExampleConnection_GetProject() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetProject")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -58,4 +67,172 @@ func ExampleConnection_GetProject() {
 	fmt.Println("ID:", project.ID)
 	fmt.Println("Name:", project.Name)
 	fmt.Println("Status:", project.Status)
+	// Output:
+	// GetProject
+	// ID: 158747
+	// Name: Mobile App
+	// Status: active
+}
+
+func ExampleConnection_CreateProject() {
+	// a fake TeamWork to create the project in
+	server := teamworktest.NewServer()
+	defer server.Close()
+
+	// setup the teamwork connection
+	conn, err := server.Connect()
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// create a project
+	createResponse, err := conn.CreateProject(&teamwork.CreateProjectOps{
+		Name:        "Client Onboarding",
+		Description: "Getting the new client started",
+		StartDate:   "20201001",
+	})
+	if err != nil {
+		fmt.Printf("Error creating Project: %s", err.Error())
+		os.Exit(1)
+	}
+	fmt.Println("Create Status:", createResponse.Status)
+
+	// rename, star and archive it
+	_, err = conn.UpdateProject(createResponse.ID.String(), &teamwork.UpdateProjectOps{Name: "Acme Onboarding"})
+	if err != nil {
+		fmt.Printf("Error updating Project: %s", err.Error())
+	}
+	if _, err = conn.StarProject(createResponse.ID.String()); err != nil {
+		fmt.Printf("Error starring Project: %s", err.Error())
+	}
+	if _, err = conn.ArchiveProject(createResponse.ID.String()); err != nil {
+		fmt.Printf("Error archiving Project: %s", err.Error())
+	}
+
+	project, err := conn.GetProject(createResponse.ID.String(), &teamwork.GetProjectOps{})
+	if err != nil {
+		fmt.Printf("Error getting Project: %s", err.Error())
+	}
+	fmt.Println("Name:", project.Name)
+	fmt.Println("Starred:", project.Starred)
+	fmt.Println("Status:", project.Status)
+	// Output:
+	// Create Status: OK
+	// Name: Acme Onboarding
+	// Starred: true
+	// Status: archived
+}
+
+func TestProjectRequests(t *testing.T) {
+	conn := connectTo(t,
+		apiCall{
+			method:   "POST",
+			path:     "/projects.json",
+			body:     `{"project": {"name": "Client Onboarding", "description": "Getting the new client started", "companyId": "12345", "start-date": "20201001", "use-time": false}}`,
+			response: `{"id": "158800", "STATUS": "OK"}`,
+		},
+		apiCall{method: "PUT", path: "/projects/158800.json", body: `{"project": {"name": "Acme Onboarding", "tags": "onboarding,acme"}}`},
+		apiCall{method: "PUT", path: "/projects/158800.json", body: `{"project": {"status": "archived"}}`},
+		apiCall{method: "PUT", path: "/projects/158800.json", body: `{"project": {"status": "active"}}`},
+		apiCall{method: "PUT", path: "/projects/158800/star.json"},
+		apiCall{method: "PUT", path: "/projects/158800/unstar.json"},
+		apiCall{method: "DELETE", path: "/projects/158800.json"},
+	)
+
+	useTime := false
+	createResponse, err := conn.CreateProject(&teamwork.CreateProjectOps{
+		Name:        "Client Onboarding",
+		Description: "Getting the new client started",
+		CompanyID:   "12345",
+		StartDate:   "20201001",
+		UseTime:     &useTime,
+	})
+	if err != nil || createResponse.ID != "158800" {
+		t.Fatalf("CreateProject() = %+v, %v, want 158800", createResponse, err)
+	}
+	if _, err := conn.UpdateProject("158800", &teamwork.UpdateProjectOps{Name: "Acme Onboarding", Tags: "onboarding,acme"}); err != nil {
+		t.Errorf("UpdateProject() error = %v", err)
+	}
+	if _, err := conn.ArchiveProject("158800"); err != nil {
+		t.Errorf("ArchiveProject() error = %v", err)
+	}
+	if _, err := conn.ReactivateProject("158800"); err != nil {
+		t.Errorf("ReactivateProject() error = %v", err)
+	}
+	if _, err := conn.StarProject("158800"); err != nil {
+		t.Errorf("StarProject() error = %v", err)
+	}
+	if _, err := conn.UnstarProject("158800"); err != nil {
+		t.Errorf("UnstarProject() error = %v", err)
+	}
+	if _, err := conn.DeleteProject("158800"); err != nil {
+		t.Errorf("DeleteProject() error = %v", err)
+	}
+}
+
+func TestProjectPeople(t *testing.T) {
+	conn := connectTo(t,
+		apiCall{method: "PUT", path: "/projects/158721/people.json", body: `{"add": {"userIdList": "85457,85458"}}`},
+		apiCall{method: "DELETE", path: "/projects/158721/people/85458.json"},
+		apiCall{
+			method:   "GET",
+			path:     "/projects/158721/people.json",
+			response: `{"people": [{"id": "85457"}, {"id": "85458"}], "STATUS": "OK"}`,
+		},
+		apiCall{method: "PUT", path: "/projects/158721/people.json", body: `{"add": {"userIdList": "85459"}, "remove": {"userIdList": "85458"}}`},
+	)
+
+	if _, err := conn.AddPeopleToProject("158721", []string{"85457", "85458"}); err != nil {
+		t.Errorf("AddPeopleToProject() error = %v", err)
+	}
+	if _, err := conn.RemovePersonFromProject("158721", "85458"); err != nil {
+		t.Errorf("RemovePersonFromProject() error = %v", err)
+	}
+	if _, err := conn.SetProjectPeople("158721", []string{"85457", "85459"}); err != nil {
+		t.Errorf("SetProjectPeople() error = %v", err)
+	}
+}
+
+func TestUpdateProjectPermissions(t *testing.T) {
+	conn := connectTo(t, apiCall{
+		method: "PUT",
+		path:   "/projects/158721/people/85457.json",
+		body: `{"permissions": {
+			"add-files": "0",
+			"add-links": "0",
+			"add-messages": "0",
+			"add-milestones": "0",
+			"add-notebooks": "0",
+			"add-people-to-project": "0",
+			"add-taskLists": "0",
+			"add-tasks": "1",
+			"add-time": "1",
+			"can-be-assigned-to-tasks-and-milestones": "1",
+			"can-receive-email": "0",
+			"edit-all-tasks": "0",
+			"is-observing": "0",
+			"project-administrator": "0",
+			"set-privacy": "0",
+			"view-all-time-logs": "0",
+			"view-estimated-time": "0",
+			"view-invoices": "0",
+			"view-links": "0",
+			"view-messages-and-files": "0",
+			"view-notebooks": "0",
+			"view-risk-register": "0",
+			"view-tasks-and-milestones": "1",
+			"view-time": "0"
+		}}`,
+	})
+
+	_, err := conn.UpdateProjectPermissions("158721", "85457", &teamwork.ProjectPermissions{
+		AddTasks:                          true,
+		AddTime:                           true,
+		CanBeAssignedToTasksAndMilestones: true,
+		ViewTasksAndMilestones:            true,
+	})
+	if err != nil {
+		t.Errorf("UpdateProjectPermissions() error = %v", err)
+	}
 }
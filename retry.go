@@ -0,0 +1,166 @@
+package teamwork
+
+import (
+	"io"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit is the rate limit TeamWork reported in the X-RateLimit-*
+// headers of the most recent response.
+type RateLimit struct {
+	// The number of requests allowed per period.
+	Limit int `header:"X-RateLimit-Limit"`
+	// The number of requests remaining in the current period.
+	Remaining int `header:"X-RateLimit-Remaining"`
+	// The number of seconds until the current period resets.
+	Reset int `header:"X-RateLimit-Reset"`
+	// When the rate limit was observed.  Zero if TeamWork has not
+	// reported a rate limit yet.
+	ObservedAt time.Time
+}
+
+// RateLimit returns the rate limit TeamWork reported in the most recent response.
+func (conn *Connection) RateLimit() RateLimit {
+	if conn.retry == nil {
+		return RateLimit{}
+	}
+	return conn.retry.rateLimit()
+}
+
+// retryTransport is an http.RoundTripper which retries idempotent requests
+// that fail with a 429 or 5xx status code and keeps track of the rate
+// limit reported by TeamWork.  Every attempt, retries included, waits on
+// the limiter if there is one.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	limiter    *Limiter
+	logger     *slog.Logger
+
+	mu    sync.Mutex
+	limit RateLimit
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		if t.limiter != nil {
+			if err := t.limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.next.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+		t.observe(resp.Header)
+
+		if attempt >= t.maxRetries || !retryable(req, resp) {
+			return resp, nil
+		}
+
+		wait := t.backoff(attempt, resp)
+		t.logger.Warn("teamwork: retrying request", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode, "attempt", attempt+1, "wait", wait)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable reports whether the request can be sent again after the response.
+// Only idempotent methods which can rewind their body are retried.
+func retryable(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+		return false
+	}
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+	default:
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// backoff is how long to wait before the next attempt.  The Retry-After and
+// X-RateLimit-Reset headers are honored up to maxBackoff, otherwise the wait
+// grows exponentially from minBackoff up to maxBackoff with jitter.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if wait, ok := retryAfter(resp.Header); ok {
+		return min(wait, t.maxBackoff)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if reset, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Reset")); err == nil && reset > 0 {
+			return min(time.Duration(reset)*time.Second, t.maxBackoff)
+		}
+	}
+	wait := t.minBackoff << uint(attempt)
+	if wait <= 0 || wait > t.maxBackoff {
+		wait = t.maxBackoff
+	}
+	// full jitter in the upper half of the window
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// retryAfter parses the Retry-After header in either its seconds or HTTP date form.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// observe records the rate limit headers, if any, of a response.
+func (t *retryTransport) observe(header http.Header) {
+	limit := RateLimit{}
+	if header.Get("X-RateLimit-Limit") == "" || getHeaders(header, &limit) != nil {
+		return
+	}
+	limit.ObservedAt = time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limit = limit
+}
+
+// rateLimit returns the last rate limit observed.
+func (t *retryTransport) rateLimit() RateLimit {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.limit
+}
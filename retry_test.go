@@ -0,0 +1,140 @@
+package teamwork_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/swill/teamwork"
+)
+
+func ExampleConnection_RateLimit() {
+	// a stand-in for TeamWork which throttles the first call for projects
+	throttled := false
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		w.Header().Set("X-RateLimit-Limit", "150")
+		if !throttled {
+			throttled = true
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("X-RateLimit-Remaining", "149")
+		w.Header().Set("X-RateLimit-Reset", "60")
+		fmt.Fprint(w, `{"projects": [{"name": "Website"}], "STATUS": "OK"}`)
+	}))
+	defer server.Close()
+
+	// setup the teamwork connection
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken",
+		teamwork.WithRetries(2),
+		teamwork.WithBackoff(10*time.Millisecond, time.Second),
+	)
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// the throttled call is retried
+	projects, _, err := conn.GetProjects(&teamwork.GetProjectsOps{})
+	if err != nil {
+		fmt.Printf("Error getting Projects: %s", err.Error())
+	}
+
+	rateLimit := conn.RateLimit()
+	fmt.Println("1. Name:", projects[0].Name)
+	fmt.Println("Limit:", rateLimit.Limit)
+	fmt.Println("Remaining:", rateLimit.Remaining)
+	fmt.Println("Reset:", rateLimit.Reset)
+	// Output:
+	// 1. Name: Website
+	// Limit: 150
+	// Remaining: 149
+	// Reset: 60
+}
+
+func TestRetryWaitsOnLimiter(t *testing.T) {
+	// a stand-in for TeamWork which throttles the first two calls for projects
+	calls := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		calls++
+		if calls <= 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"projects": [], "STATUS": "OK"}`)
+	}))
+	defer server.Close()
+
+	// three calls, then one a minute: authenticating and two attempts
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken",
+		teamwork.WithRateLimit(1, 3),
+		teamwork.WithRetries(5),
+		teamwork.WithBackoff(time.Millisecond, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, _, err = conn.GetProjectsContext(ctx, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetProjectsContext() error = %v, want the third attempt held by the limiter", err)
+	}
+	if calls != 2 {
+		t.Errorf("TeamWork got %d calls for projects, want 2", calls)
+	}
+}
+
+func TestRetryCapsRetryAfter(t *testing.T) {
+	// a stand-in for TeamWork which asks for a day's wait on the first call for projects
+	calls := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "86400")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"projects": [], "STATUS": "OK"}`)
+	}))
+	defer server.Close()
+
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken",
+		teamwork.WithRetries(1),
+		teamwork.WithBackoff(time.Millisecond, 10*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, _, err := conn.GetProjectsContext(ctx, nil); err != nil {
+		t.Errorf("GetProjectsContext() error = %v, want the retry after at most 10ms", err)
+	}
+	if calls != 2 {
+		t.Errorf("TeamWork got %d calls for projects, want 2", calls)
+	}
+}
@@ -1,9 +1,10 @@
 package teamwork
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"time"
+	"iter"
 )
 
 // Tasks is a list of Task
@@ -12,82 +13,82 @@ type Tasks []Task
 // The Task structure.
 type Task struct {
 	Attachments []struct {
-		CategoryID   int    `json:"categoryId"`
+		CategoryID   ID     `json:"categoryId"`
 		CategoryName string `json:"categoryName"`
-		FileID       int    `json:"fileId"`
+		FileID       ID     `json:"fileId"`
 		Filename     string `json:"filename"`
-		ID           int    `json:"id"`
+		ID           ID     `json:"id"`
 		Name         string `json:"name"`
 		Size         string `json:"size"`
 		Version      string `json:"version"`
 	} `json:"attachments,omitempty"`
-	AttachmentsCount          int           `json:"attachments-count"`
-	CanComplete               bool          `json:"canComplete"`
-	CanEdit                   bool          `json:"canEdit"`
-	CanLogTime                bool          `json:"canLogTime"`
+	AttachmentsCount          FlexInt       `json:"attachments-count"`
+	CanComplete               FlexBool      `json:"canComplete"`
+	CanEdit                   FlexBool      `json:"canEdit"`
+	CanLogTime                FlexBool      `json:"canLogTime"`
 	CommentFollowerIds        string        `json:"commentFollowerIds,omitempty"`
 	CommentFollowerSummary    string        `json:"commentFollowerSummary,omitempty"`
-	CommentsCount             int           `json:"comments-count"`
-	CompanyID                 int           `json:"company-id"`
+	CommentsCount             FlexInt       `json:"comments-count"`
+	CompanyID                 ID            `json:"company-id"`
 	CompanyName               string        `json:"company-name"`
-	Completed                 bool          `json:"completed"`
-	CompletedOn               time.Time     `json:"completed_on,omitempty"`
+	Completed                 FlexBool      `json:"completed"`
+	CompletedOn               Time          `json:"completed_on,omitempty"`
 	CompleterFirstname        string        `json:"completer_firstname,omitempty"`
-	CompleterID               string        `json:"completer_id,omitempty"`
+	CompleterID               ID            `json:"completer_id,omitempty"`
 	CompleterLastname         string        `json:"completer_lastname,omitempty"`
 	Content                   string        `json:"content"`
-	CreatedOn                 time.Time     `json:"created-on"`
+	CreatedOn                 Time          `json:"created-on"`
 	CreatorAvatarURL          string        `json:"creator-avatar-url"`
 	CreatorFirstname          string        `json:"creator-firstname"`
-	CreatorID                 int           `json:"creator-id"`
+	CreatorID                 ID            `json:"creator-id"`
 	CreatorLastname           string        `json:"creator-lastname"`
 	Description               string        `json:"description"`
-	DLM                       int           `json:"DLM"`
-	DueDate                   string        `json:"due-date"`
+	DLM                       FlexInt       `json:"DLM"`
+	DueDate                   Date          `json:"due-date"`
 	DueDateBase               string        `json:"due-date-base"`
-	EstimatedMinutes          int           `json:"estimated-minutes"`
-	HarvestEnabled            bool          `json:"harvest-enabled"`
-	HasDependencies           int           `json:"has-dependencies"`
-	HasPredecessors           int           `json:"has-predecessors"`
-	HasReminders              bool          `json:"has-reminders"`
-	HasTickets                bool          `json:"hasTickets"`
-	HasUnreadComments         bool          `json:"has-unread-comments"`
-	ID                        int           `json:"id"`
-	LastChangedOn             time.Time     `json:"last-changed-on"`
+	EstimatedMinutes          FlexInt       `json:"estimated-minutes"`
+	HarvestEnabled            FlexBool      `json:"harvest-enabled"`
+	HasDependencies           FlexInt       `json:"has-dependencies"`
+	HasPredecessors           FlexInt       `json:"has-predecessors"`
+	HasReminders              FlexBool      `json:"has-reminders"`
+	HasTickets                FlexBool      `json:"hasTickets"`
+	HasUnreadComments         FlexBool      `json:"has-unread-comments"`
+	ID                        ID            `json:"id"`
+	LastChangedOn             Time          `json:"last-changed-on"`
 	LockdownID                string        `json:"lockdownId"`
-	Order                     int           `json:"order"`
-	ParentTaskID              string        `json:"parentTaskId"`
-	Position                  int           `json:"position"`
+	Order                     FlexInt       `json:"order"`
+	ParentTaskID              ID            `json:"parentTaskId"`
+	Position                  FlexInt       `json:"position"`
 	Predecessors              []interface{} `json:"predecessors"`
 	Priority                  string        `json:"priority"`
-	Private                   int           `json:"private"`
-	Progress                  int           `json:"progress"`
-	ProjectID                 int           `json:"project-id"`
+	Private                   FlexInt       `json:"private"`
+	Progress                  FlexInt       `json:"progress"`
+	ProjectID                 ID            `json:"project-id"`
 	ProjectName               string        `json:"project-name"`
 	ResponsiblePartyFirstname string        `json:"responsible-party-firstname,omitempty"`
-	ResponsiblePartyID        string        `json:"responsible-party-id,omitempty"`
+	ResponsiblePartyID        ID            `json:"responsible-party-id,omitempty"`
 	ResponsiblePartyIds       string        `json:"responsible-party-ids,omitempty"`
 	ResponsiblePartyLastname  string        `json:"responsible-party-lastname,omitempty"`
 	ResponsiblePartyNames     string        `json:"responsible-party-names,omitempty"`
 	ResponsiblePartySummary   string        `json:"responsible-party-summary,omitempty"`
 	ResponsiblePartyType      string        `json:"responsible-party-type,omitempty"`
-	StartDate                 string        `json:"start-date"`
+	StartDate                 Date          `json:"start-date"`
 	Status                    string        `json:"status"`
 	SubTasks                  []Task        `json:"subTasks,omitempty"`
-	TaskListID                int           `json:"todo-list-id"`
+	TaskListID                ID            `json:"todo-list-id"`
 	TaskListName              string        `json:"todo-list-name"`
-	TaskListIsTemplate        bool          `json:"tasklist-isTemplate"`
+	TaskListIsTemplate        FlexBool      `json:"tasklist-isTemplate"`
 	TaskListLockdownID        string        `json:"tasklist-lockdownId"`
-	TaskListPrivate           bool          `json:"tasklist-private"`
+	TaskListPrivate           FlexBool      `json:"tasklist-private"`
 	Tags                      []struct {
 		Color string `json:"color"`
-		ID    int    `json:"id"`
+		ID    ID     `json:"id"`
 		Name  string `json:"name"`
 	} `json:"tags,omitempty"`
-	TimeIsLogged          string `json:"timeIsLogged"`
-	UserFollowingChanges  bool   `json:"userFollowingChanges"`
-	UserFollowingComments bool   `json:"userFollowingComments"`
-	ViewEstimatedTime     bool   `json:"viewEstimatedTime"`
+	TimeIsLogged          FlexBool `json:"timeIsLogged"`
+	UserFollowingChanges  FlexBool `json:"userFollowingChanges"`
+	UserFollowingComments FlexBool `json:"userFollowingComments"`
+	ViewEstimatedTime     FlexBool `json:"viewEstimatedTime"`
 }
 
 // GetTasksOps is used to generate the query params for the
@@ -197,19 +198,24 @@ type GetTasksOps struct {
 //
 // ref: http://developer.teamwork.com/timetracking#retrieve_all_time
 func (conn *Connection) GetTasks(ops *GetTasksOps) (Tasks, Pages, error) {
+	return conn.GetTasksContext(context.Background(), ops)
+}
+
+// GetTasksContext is like GetTasks but uses ctx for the request.
+func (conn *Connection) GetTasksContext(ctx context.Context, ops *GetTasksOps) (Tasks, Pages, error) {
 	tasks := make(Tasks, 0)
 	pages := &Pages{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%stasks.json%s", conn.Account.Url, params)
-	reader, headers, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%stasks.json%s", conn.baseURL, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return tasks, *pages, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
-	getHeaders(headers, pages)
 	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return tasks, *pages, err
+	}
 
 	err = json.NewDecoder(reader).Decode(&struct {
 		*Tasks `json:"todo-items"`
@@ -221,24 +227,63 @@ func (conn *Connection) GetTasks(ops *GetTasksOps) (Tasks, Pages, error) {
 	return tasks, *pages, nil
 }
 
+// AllTasks returns an iterator over all the tasks according to the
+// specified GetTasksOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllTasks(ctx context.Context, ops *GetTasksOps) iter.Seq2[Task, error] {
+	return conn.tasksPager(ops).all(ctx)
+}
+
+// tasksPager fetches the pages for AllTasks and GetAllTasks.
+func (conn *Connection) tasksPager(ops *GetTasksOps) pager[Task] {
+	pageOps := GetTasksOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Task]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Task, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetTasksContext(ctx, &ops)
+		},
+	}
+}
+
+// GetAllTasks gets all the tasks according to the specified
+// GetTasksOps, walking every page.
+func (conn *Connection) GetAllTasks(ops *GetTasksOps) (Tasks, error) {
+	return conn.GetAllTasksContext(context.Background(), ops)
+}
+
+// GetAllTasksContext is like GetAllTasks but uses ctx for the requests.
+func (conn *Connection) GetAllTasksContext(ctx context.Context, ops *GetTasksOps) (Tasks, error) {
+	return conn.tasksPager(ops).collect(ctx, conn.pageWorkers)
+}
+
 // GetProjectTasks gets all the project tasks available according to the specified
 // GetTasksOps which are passed in.
 //
 // ref: http://developer.teamwork.com/timetracking#retrieve_all_time
 func (conn *Connection) GetProjectTasks(id string, ops *GetTasksOps) (Tasks, Pages, error) {
+	return conn.GetProjectTasksContext(context.Background(), id, ops)
+}
+
+// GetProjectTasksContext is like GetProjectTasks but uses ctx for the request.
+func (conn *Connection) GetProjectTasksContext(ctx context.Context, id string, ops *GetTasksOps) (Tasks, Pages, error) {
 	tasks := make(Tasks, 0)
 	pages := &Pages{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%sprojects/%s/tasks.json%s", conn.Account.Url, id, params)
-	reader, headers, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%sprojects/%s/tasks.json%s", conn.baseURL, id, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return tasks, *pages, err
 	}
-	// data, _ := ioutil.ReadAll(reader)
-	// fmt.Printf(string(data))
-	getHeaders(headers, pages)
 	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return tasks, *pages, err
+	}
 
 	err = json.NewDecoder(reader).Decode(&struct {
 		*Tasks `json:"todo-items"`
@@ -250,24 +295,63 @@ func (conn *Connection) GetProjectTasks(id string, ops *GetTasksOps) (Tasks, Pag
 	return tasks, *pages, nil
 }
 
+// AllProjectTasks returns an iterator over all the project tasks according to the
+// specified GetTasksOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllProjectTasks(ctx context.Context, id string, ops *GetTasksOps) iter.Seq2[Task, error] {
+	return conn.projectTasksPager(id, ops).all(ctx)
+}
+
+// projectTasksPager fetches the pages for AllProjectTasks and GetAllProjectTasks.
+func (conn *Connection) projectTasksPager(id string, ops *GetTasksOps) pager[Task] {
+	pageOps := GetTasksOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Task]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Task, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetProjectTasksContext(ctx, id, &ops)
+		},
+	}
+}
+
+// GetAllProjectTasks gets all the project tasks according to the specified
+// GetTasksOps, walking every page.
+func (conn *Connection) GetAllProjectTasks(id string, ops *GetTasksOps) (Tasks, error) {
+	return conn.GetAllProjectTasksContext(context.Background(), id, ops)
+}
+
+// GetAllProjectTasksContext is like GetAllProjectTasks but uses ctx for the requests.
+func (conn *Connection) GetAllProjectTasksContext(ctx context.Context, id string, ops *GetTasksOps) (Tasks, error) {
+	return conn.projectTasksPager(id, ops).collect(ctx, conn.pageWorkers)
+}
+
 // GetTaskListTasks gets all the task list tasks available according to the specified
 // GetTasksOps which are passed in.
 //
 // ref: http://developer.teamwork.com/timetracking#retrieve_all_time
 func (conn *Connection) GetTaskListTasks(id string, ops *GetTasksOps) (Tasks, Pages, error) {
+	return conn.GetTaskListTasksContext(context.Background(), id, ops)
+}
+
+// GetTaskListTasksContext is like GetTaskListTasks but uses ctx for the request.
+func (conn *Connection) GetTaskListTasksContext(ctx context.Context, id string, ops *GetTasksOps) (Tasks, Pages, error) {
 	tasks := make(Tasks, 0)
 	pages := &Pages{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%stasklists/%s/tasks.json%s", conn.Account.Url, id, params)
-	reader, headers, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%stasklists/%s/tasks.json%s", conn.baseURL, id, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return tasks, *pages, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
-	getHeaders(headers, pages)
 	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return tasks, *pages, err
+	}
 
 	err = json.NewDecoder(reader).Decode(&struct {
 		*Tasks `json:"todo-items"`
@@ -279,25 +363,252 @@ func (conn *Connection) GetTaskListTasks(id string, ops *GetTasksOps) (Tasks, Pa
 	return tasks, *pages, nil
 }
 
+// AllTaskListTasks returns an iterator over all the task list tasks according to the
+// specified GetTasksOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllTaskListTasks(ctx context.Context, id string, ops *GetTasksOps) iter.Seq2[Task, error] {
+	return conn.taskListTasksPager(id, ops).all(ctx)
+}
+
+// taskListTasksPager fetches the pages for AllTaskListTasks and GetAllTaskListTasks.
+func (conn *Connection) taskListTasksPager(id string, ops *GetTasksOps) pager[Task] {
+	pageOps := GetTasksOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[Task]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]Task, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetTaskListTasksContext(ctx, id, &ops)
+		},
+	}
+}
+
+// GetAllTaskListTasks gets all the task list tasks according to the specified
+// GetTasksOps, walking every page.
+func (conn *Connection) GetAllTaskListTasks(id string, ops *GetTasksOps) (Tasks, error) {
+	return conn.GetAllTaskListTasksContext(context.Background(), id, ops)
+}
+
+// GetAllTaskListTasksContext is like GetAllTaskListTasks but uses ctx for the requests.
+func (conn *Connection) GetAllTaskListTasksContext(ctx context.Context, id string, ops *GetTasksOps) (Tasks, error) {
+	return conn.taskListTasksPager(id, ops).collect(ctx, conn.pageWorkers)
+}
+
+// CreateTaskOps is used to generate the body for the
+// CreateTask and CreateSubTask API calls.
+type CreateTaskOps struct {
+	// The title of the task.
+	Content string `json:"content"`
+	// The description of the task.
+	Description string `json:"description,omitempty"`
+	// A comma separated list of the Person IDs the task is assigned to.
+	ResponsiblePartyID string `json:"responsible-party-id,omitempty"`
+	// Format: "YYYYMMDD"
+	StartDate string `json:"start-date,omitempty"`
+	// Format: "YYYYMMDD"
+	DueDate string `json:"due-date,omitempty"`
+	// The estimated time to complete the task, in minutes.
+	EstimatedMinutes *int `json:"estimated-minutes,omitempty"`
+	// Valid Input: "low", "medium", "high"
+	Priority string `json:"priority,omitempty"`
+	// The percentage of the task which is done.
+	// Valid Input: 0 - 100
+	Progress *int `json:"progress,omitempty"`
+	// A comma separated list of tag names.
+	Tags string `json:"tags,omitempty"`
+	// Only the people in the task's notify list can see a private task.
+	// Valid Input: true, false
+	Private *bool `json:"private,omitempty"`
+	// Notify the people the task is assigned to.
+	// Valid Input: true, false
+	Notify *bool `json:"notify,omitempty"`
+}
+
+// UpdateTaskOps is used to generate the body for the
+// UpdateTask API call.  Only the fields which are set are updated.
+type UpdateTaskOps struct {
+	// The title of the task.
+	Content string `json:"content,omitempty"`
+	// The description of the task.
+	Description string `json:"description,omitempty"`
+	// A comma separated list of the Person IDs the task is assigned to.
+	ResponsiblePartyID string `json:"responsible-party-id,omitempty"`
+	// Format: "YYYYMMDD"
+	StartDate string `json:"start-date,omitempty"`
+	// Format: "YYYYMMDD"
+	DueDate string `json:"due-date,omitempty"`
+	// The estimated time to complete the task, in minutes.
+	EstimatedMinutes *int `json:"estimated-minutes,omitempty"`
+	// Valid Input: "low", "medium", "high"
+	Priority string `json:"priority,omitempty"`
+	// The percentage of the task which is done.
+	// Valid Input: 0 - 100
+	Progress *int `json:"progress,omitempty"`
+	// A comma separated list of tag names.
+	Tags string `json:"tags,omitempty"`
+	// Only the people in the task's notify list can see a private task.
+	// Valid Input: true, false
+	Private *bool `json:"private,omitempty"`
+	// Notify the people the task is assigned to.
+	// Valid Input: true, false
+	Notify *bool `json:"notify,omitempty"`
+	// The ID of the task list to move the task to.
+	TaskListID string `json:"tasklistId,omitempty"`
+}
+
+// CreateTaskResponse captures the response returned from a create task action
+type CreateTaskResponse struct {
+	ID     ID     `json:"id"`
+	Status string `json:"STATUS"`
+}
+
+// CreateTask creates a task on the task list with the specified ID
+// according to the CreateTaskOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/tasks/post-tasklists-id-tasks-json
+func (conn *Connection) CreateTask(taskListID string, ops *CreateTaskOps) (*CreateTaskResponse, error) {
+	return conn.CreateTaskContext(context.Background(), taskListID, ops)
+}
+
+// CreateTaskContext is like CreateTask but uses ctx for the request.
+func (conn *Connection) CreateTaskContext(ctx context.Context, taskListID string, ops *CreateTaskOps) (*CreateTaskResponse, error) {
+	url := fmt.Sprintf("%stasklists/%s/tasks.json", conn.baseURL, taskListID)
+	return conn.createTask(ctx, url, ops)
+}
+
+// CreateSubTask creates a sub task of the task with the specified ID
+// according to the CreateTaskOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/tasks/post-tasks-id-json
+func (conn *Connection) CreateSubTask(taskID string, ops *CreateTaskOps) (*CreateTaskResponse, error) {
+	return conn.CreateSubTaskContext(context.Background(), taskID, ops)
+}
+
+// CreateSubTaskContext is like CreateSubTask but uses ctx for the request.
+func (conn *Connection) CreateSubTaskContext(ctx context.Context, taskID string, ops *CreateTaskOps) (*CreateTaskResponse, error) {
+	url := fmt.Sprintf("%stasks/%s.json", conn.baseURL, taskID)
+	return conn.createTask(ctx, url, ops)
+}
+
+// createTask posts a task to url for CreateTask and CreateSubTask.
+func (conn *Connection) createTask(ctx context.Context, url string, ops *CreateTaskOps) (*CreateTaskResponse, error) {
+	createResponse := &CreateTaskResponse{}
+	err := conn.sendJSON(ctx, "POST", url, struct {
+		Task *CreateTaskOps `json:"todo-item"`
+	}{ops}, createResponse)
+	if err != nil {
+		return nil, err
+	}
+	return createResponse, nil
+}
+
+// UpdateTask updates a task according to the specified
+// UpdateTaskOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/tasks/put-tasks-id-json
+func (conn *Connection) UpdateTask(id string, ops *UpdateTaskOps) (*StatusResponse, error) {
+	return conn.UpdateTaskContext(context.Background(), id, ops)
+}
+
+// UpdateTaskContext is like UpdateTask but uses ctx for the request.
+func (conn *Connection) UpdateTaskContext(ctx context.Context, id string, ops *UpdateTaskOps) (*StatusResponse, error) {
+	updateResponse := &StatusResponse{}
+	url := fmt.Sprintf("%stasks/%s.json", conn.baseURL, id)
+	err := conn.sendJSON(ctx, "PUT", url, struct {
+		Task *UpdateTaskOps `json:"todo-item"`
+	}{ops}, updateResponse)
+	if err != nil {
+		return nil, err
+	}
+	return updateResponse, nil
+}
+
+// MoveTask moves a task to the task list with the specified ID.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/tasks/put-tasks-id-json
+func (conn *Connection) MoveTask(id, taskListID string) (*StatusResponse, error) {
+	return conn.MoveTaskContext(context.Background(), id, taskListID)
+}
+
+// MoveTaskContext is like MoveTask but uses ctx for the request.
+func (conn *Connection) MoveTaskContext(ctx context.Context, id, taskListID string) (*StatusResponse, error) {
+	return conn.UpdateTaskContext(ctx, id, &UpdateTaskOps{TaskListID: taskListID})
+}
+
+// CompleteTask marks a task as completed.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/tasks/put-tasks-id-complete-json
+func (conn *Connection) CompleteTask(id string) (*StatusResponse, error) {
+	return conn.CompleteTaskContext(context.Background(), id)
+}
+
+// CompleteTaskContext is like CompleteTask but uses ctx for the request.
+func (conn *Connection) CompleteTaskContext(ctx context.Context, id string) (*StatusResponse, error) {
+	completeResponse := &StatusResponse{}
+	url := fmt.Sprintf("%stasks/%s/complete.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "PUT", url, nil, completeResponse); err != nil {
+		return nil, err
+	}
+	return completeResponse, nil
+}
+
+// UncompleteTask marks a completed task as not completed.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/tasks/put-tasks-id-uncomplete-json
+func (conn *Connection) UncompleteTask(id string) (*StatusResponse, error) {
+	return conn.UncompleteTaskContext(context.Background(), id)
+}
+
+// UncompleteTaskContext is like UncompleteTask but uses ctx for the request.
+func (conn *Connection) UncompleteTaskContext(ctx context.Context, id string) (*StatusResponse, error) {
+	uncompleteResponse := &StatusResponse{}
+	url := fmt.Sprintf("%stasks/%s/uncomplete.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "PUT", url, nil, uncompleteResponse); err != nil {
+		return nil, err
+	}
+	return uncompleteResponse, nil
+}
+
+// DeleteTask deletes a task.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/tasks/delete-tasks-id-json
+func (conn *Connection) DeleteTask(id string) (*StatusResponse, error) {
+	return conn.DeleteTaskContext(context.Background(), id)
+}
+
+// DeleteTaskContext is like DeleteTask but uses ctx for the request.
+func (conn *Connection) DeleteTaskContext(ctx context.Context, id string) (*StatusResponse, error) {
+	deleteResponse := &StatusResponse{}
+	url := fmt.Sprintf("%stasks/%s.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "DELETE", url, nil, deleteResponse); err != nil {
+		return nil, err
+	}
+	return deleteResponse, nil
+}
+
 // TaskLists is a list of TaskList
 type TaskLists []TaskList
 
 // The TaskList structure.
 type TaskList struct {
-	Complete         bool        `json:"complete"`
-	Description      string      `json:"description"`
-	DLM              interface{} `json:"DLM"`
-	ID               string      `json:"id"`
-	IsTemplate       bool        `json:"isTemplate"`
-	MilestoneID      string      `json:"milestone-id"`
-	Name             string      `json:"name"`
-	Pinned           bool        `json:"pinned"`
-	Position         int         `json:"position"`
-	Private          bool        `json:"private"`
-	ProjectID        string      `json:"projectId"`
-	ProjectName      string      `json:"projectName"`
-	Status           string      `json:"status"`
-	UncompletedCount int         `json:"uncompleted-count"`
+	Complete         FlexBool   `json:"complete"`
+	Description      string     `json:"description"`
+	DLM              FlexInt    `json:"DLM"`
+	ID               ID         `json:"id"`
+	IsTemplate       FlexBool   `json:"isTemplate"`
+	Milestone        *Milestone `json:"milestone,omitempty"` // with ShowMilestones
+	MilestoneID      ID         `json:"milestone-id"`
+	Name             string     `json:"name"`
+	Pinned           FlexBool   `json:"pinned"`
+	Position         FlexInt    `json:"position"`
+	Private          FlexBool   `json:"private"`
+	ProjectID        ID         `json:"projectId"`
+	ProjectName      string     `json:"projectName"`
+	Status           string     `json:"status"`
+	UncompletedCount FlexInt    `json:"uncompleted-count"`
 }
 
 // GetProjectTaskListsOps is used to generate the query params for the
@@ -323,6 +634,8 @@ type GetProjectTaskListsOps struct {
 	ShowMilestones string `param:"showMilestones"`
 	// Filter by the person responsible.
 	ResponsiblePartyID string `param:"responsible-party-id"`
+	// A page of results.  Access additional pages.  (eg: 2, etc...)
+	Page *int `param:"page"`
 }
 
 // GetProjectTaskLists gets all the task lists available according to the specified
@@ -330,19 +643,24 @@ type GetProjectTaskListsOps struct {
 //
 // ref: http://developer.teamwork.com/tasklists#get_all_task_list
 func (conn *Connection) GetProjectTaskLists(id string, ops *GetProjectTaskListsOps) (TaskLists, Pages, error) {
+	return conn.GetProjectTaskListsContext(context.Background(), id, ops)
+}
+
+// GetProjectTaskListsContext is like GetProjectTaskLists but uses ctx for the request.
+func (conn *Connection) GetProjectTaskListsContext(ctx context.Context, id string, ops *GetProjectTaskListsOps) (TaskLists, Pages, error) {
 	taskLists := make(TaskLists, 0)
 	pages := &Pages{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%sprojects/%s/tasklists.json%s", conn.Account.Url, id, params)
-	reader, headers, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%sprojects/%s/tasklists.json%s", conn.baseURL, id, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return taskLists, *pages, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
-	getHeaders(headers, pages)
 	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return taskLists, *pages, err
+	}
 
 	err = json.NewDecoder(reader).Decode(&struct {
 		*TaskLists `json:"tasklists"`
@@ -353,3 +671,249 @@ func (conn *Connection) GetProjectTaskLists(id string, ops *GetProjectTaskListsO
 
 	return taskLists, *pages, nil
 }
+
+// AllProjectTaskLists returns an iterator over all the task lists of a project according to the
+// specified GetProjectTaskListsOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllProjectTaskLists(ctx context.Context, id string, ops *GetProjectTaskListsOps) iter.Seq2[TaskList, error] {
+	return conn.projectTaskListsPager(id, ops).all(ctx)
+}
+
+// projectTaskListsPager fetches the pages for AllProjectTaskLists and GetAllProjectTaskLists.
+func (conn *Connection) projectTaskListsPager(id string, ops *GetProjectTaskListsOps) pager[TaskList] {
+	pageOps := GetProjectTaskListsOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[TaskList]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]TaskList, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetProjectTaskListsContext(ctx, id, &ops)
+		},
+	}
+}
+
+// GetAllProjectTaskLists gets all the task lists of a project according to the specified
+// GetProjectTaskListsOps, walking every page.
+func (conn *Connection) GetAllProjectTaskLists(id string, ops *GetProjectTaskListsOps) (TaskLists, error) {
+	return conn.GetAllProjectTaskListsContext(context.Background(), id, ops)
+}
+
+// GetAllProjectTaskListsContext is like GetAllProjectTaskLists but uses ctx for the requests.
+func (conn *Connection) GetAllProjectTaskListsContext(ctx context.Context, id string, ops *GetProjectTaskListsOps) (TaskLists, error) {
+	return conn.projectTaskListsPager(id, ops).collect(ctx, conn.pageWorkers)
+}
+
+// GetTaskList gets a single task list based on the ID.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/task-lists/get-tasklists-id-json
+func (conn *Connection) GetTaskList(id string) (TaskList, error) {
+	return conn.GetTaskListContext(context.Background(), id)
+}
+
+// GetTaskListContext is like GetTaskList but uses ctx for the request.
+func (conn *Connection) GetTaskListContext(ctx context.Context, id string) (TaskList, error) {
+	taskList := &TaskList{}
+	method := "GET"
+	url := fmt.Sprintf("%stasklists/%s.json", conn.baseURL, id)
+	reader, _, err := conn.request(ctx, method, url, nil)
+	if err != nil {
+		return *taskList, err
+	}
+	defer reader.Close()
+
+	err = json.NewDecoder(reader).Decode(&struct {
+		*TaskList `json:"todo-list"`
+	}{taskList})
+	if err != nil {
+		return *taskList, err
+	}
+
+	return *taskList, nil
+}
+
+// CreateTaskListOps is used to generate the body for the
+// CreateTaskList API call.
+type CreateTaskListOps struct {
+	// The name of the task list.
+	Name string `json:"name"`
+	// The description of the task list.
+	Description string `json:"description,omitempty"`
+	// The ID of the milestone the task list belongs to.
+	MilestoneID string `json:"milestone-id,omitempty"`
+	// Only the people in the task list's notify list can see a private task list.
+	// Valid Input: true, false
+	Private *bool `json:"private,omitempty"`
+	// Pin the task list to the top of the project's task lists.
+	// Valid Input: true, false
+	Pinned *bool `json:"pinned,omitempty"`
+}
+
+// CreateTaskListFromTemplateOps is used to generate the body for the
+// CreateTaskListFromTemplate API call.
+type CreateTaskListFromTemplateOps struct {
+	// The ID of the template task list.
+	TemplateID string `json:"todo-list-template-id"`
+	// The name of the new task list.
+	// Default: the name of the template
+	Name string `json:"name,omitempty"`
+	// The date the task dates of the template are offset from.
+	// Format: "YYYYMMDD"
+	StartDate string `json:"todo-list-template-start-date"`
+	// Move task dates which land on a weekend to the following Monday.
+	// Valid Input: true, false
+	KeepOffWeekends *bool `json:"todo-list-template-keep-off-weekends,omitempty"`
+	// Who the roles of the template are assigned to, by role ID and Person ID.
+	Assignments map[string]string `json:"todo-list-template-assignments,omitempty"`
+}
+
+// UpdateTaskListOps is used to generate the body for the
+// UpdateTaskList API call.  Only the fields which are set are updated.
+type UpdateTaskListOps struct {
+	// The name of the task list.
+	Name string `json:"name,omitempty"`
+	// The description of the task list.
+	Description string `json:"description,omitempty"`
+	// The ID of the milestone the task list belongs to.
+	MilestoneID string `json:"milestone-id,omitempty"`
+	// Only the people in the task list's notify list can see a private task list.
+	// Valid Input: true, false
+	Private *bool `json:"private,omitempty"`
+	// Pin the task list to the top of the project's task lists.
+	// Valid Input: true, false
+	Pinned *bool `json:"pinned,omitempty"`
+}
+
+// CreateTaskListResponse captures the response returned from a create task list action
+type CreateTaskListResponse struct {
+	ID     ID     `json:"TASKLISTID"`
+	Status string `json:"STATUS"`
+}
+
+// CreateTaskList creates a task list in the project with the specified ID
+// according to the CreateTaskListOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/task-lists/post-projects-id-tasklists-json
+func (conn *Connection) CreateTaskList(projectID string, ops *CreateTaskListOps) (*CreateTaskListResponse, error) {
+	return conn.CreateTaskListContext(context.Background(), projectID, ops)
+}
+
+// CreateTaskListContext is like CreateTaskList but uses ctx for the request.
+func (conn *Connection) CreateTaskListContext(ctx context.Context, projectID string, ops *CreateTaskListOps) (*CreateTaskListResponse, error) {
+	createResponse := &CreateTaskListResponse{}
+	url := fmt.Sprintf("%sprojects/%s/tasklists.json", conn.baseURL, projectID)
+	err := conn.sendJSON(ctx, "POST", url, struct {
+		TaskList *CreateTaskListOps `json:"todo-list"`
+	}{ops}, createResponse)
+	if err != nil {
+		return nil, err
+	}
+	return createResponse, nil
+}
+
+// CreateTaskListFromTemplate creates a task list in the project with the specified ID
+// from a template task list, with the task dates offset from the StartDate.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/task-lists/post-projects-id-tasklists-json
+func (conn *Connection) CreateTaskListFromTemplate(projectID string, ops *CreateTaskListFromTemplateOps) (*CreateTaskListResponse, error) {
+	return conn.CreateTaskListFromTemplateContext(context.Background(), projectID, ops)
+}
+
+// CreateTaskListFromTemplateContext is like CreateTaskListFromTemplate but uses ctx for the request.
+func (conn *Connection) CreateTaskListFromTemplateContext(ctx context.Context, projectID string, ops *CreateTaskListFromTemplateOps) (*CreateTaskListResponse, error) {
+	createResponse := &CreateTaskListResponse{}
+	url := fmt.Sprintf("%sprojects/%s/tasklists.json", conn.baseURL, projectID)
+	err := conn.sendJSON(ctx, "POST", url, struct {
+		TaskList *CreateTaskListFromTemplateOps `json:"todo-list"`
+	}{ops}, createResponse)
+	if err != nil {
+		return nil, err
+	}
+	return createResponse, nil
+}
+
+// UpdateTaskList updates a task list according to the specified
+// UpdateTaskListOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/task-lists/put-tasklists-id-json
+func (conn *Connection) UpdateTaskList(id string, ops *UpdateTaskListOps) (*StatusResponse, error) {
+	return conn.UpdateTaskListContext(context.Background(), id, ops)
+}
+
+// UpdateTaskListContext is like UpdateTaskList but uses ctx for the request.
+func (conn *Connection) UpdateTaskListContext(ctx context.Context, id string, ops *UpdateTaskListOps) (*StatusResponse, error) {
+	updateResponse := &StatusResponse{}
+	url := fmt.Sprintf("%stasklists/%s.json", conn.baseURL, id)
+	err := conn.sendJSON(ctx, "PUT", url, struct {
+		TaskList *UpdateTaskListOps `json:"todo-list"`
+	}{ops}, updateResponse)
+	if err != nil {
+		return nil, err
+	}
+	return updateResponse, nil
+}
+
+// DeleteTaskList deletes a task list and its tasks.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/task-lists/delete-tasklists-id-json
+func (conn *Connection) DeleteTaskList(id string) (*StatusResponse, error) {
+	return conn.DeleteTaskListContext(context.Background(), id)
+}
+
+// DeleteTaskListContext is like DeleteTaskList but uses ctx for the request.
+func (conn *Connection) DeleteTaskListContext(ctx context.Context, id string) (*StatusResponse, error) {
+	deleteResponse := &StatusResponse{}
+	url := fmt.Sprintf("%stasklists/%s.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "DELETE", url, nil, deleteResponse); err != nil {
+		return nil, err
+	}
+	return deleteResponse, nil
+}
+
+// CompleteTaskList marks a task list and all of its tasks as completed.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/task-lists/put-tasklists-id-complete-json
+func (conn *Connection) CompleteTaskList(id string) (*StatusResponse, error) {
+	return conn.CompleteTaskListContext(context.Background(), id)
+}
+
+// CompleteTaskListContext is like CompleteTaskList but uses ctx for the request.
+func (conn *Connection) CompleteTaskListContext(ctx context.Context, id string) (*StatusResponse, error) {
+	completeResponse := &StatusResponse{}
+	url := fmt.Sprintf("%stasklists/%s/complete.json", conn.baseURL, id)
+	if err := conn.sendJSON(ctx, "PUT", url, nil, completeResponse); err != nil {
+		return nil, err
+	}
+	return completeResponse, nil
+}
+
+// ReorderTaskLists sets the order of the task lists in the project with the
+// specified ID.  The task lists are ordered as their IDs are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/task-lists/put-projects-id-tasklists-reorder-json
+func (conn *Connection) ReorderTaskLists(projectID string, taskListIDs []string) (*StatusResponse, error) {
+	return conn.ReorderTaskListsContext(context.Background(), projectID, taskListIDs)
+}
+
+// ReorderTaskListsContext is like ReorderTaskLists but uses ctx for the request.
+func (conn *Connection) ReorderTaskListsContext(ctx context.Context, projectID string, taskListIDs []string) (*StatusResponse, error) {
+	type taskListRef struct {
+		ID string `json:"id"`
+	}
+	order := make([]taskListRef, 0, len(taskListIDs))
+	for _, id := range taskListIDs {
+		order = append(order, taskListRef{ID: id})
+	}
+
+	reorderResponse := &StatusResponse{}
+	url := fmt.Sprintf("%sprojects/%s/tasklists/reorder.json", conn.baseURL, projectID)
+	err := conn.sendJSON(ctx, "PUT", url, map[string]interface{}{
+		"todo-lists": map[string]interface{}{"todo-list": order},
+	}, reorderResponse)
+	if err != nil {
+		return nil, err
+	}
+	return reorderResponse, nil
+}
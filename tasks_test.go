@@ -2,16 +2,20 @@ package teamwork_test
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"testing"
 
 	"github.com/swill/teamwork"
+	"github.com/swill/teamwork/teamworktest"
 )
 
 func ExampleConnection_GetTasks() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetTasks")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -20,7 +24,7 @@ func ExampleConnection_GetTasks() {
 	// get all tasks
 	True := true
 	PageSize := 250
-	Page := 2
+	Page := 1
 	tasksOps := &teamwork.GetTasksOps{}
 	tasksOps.GetFiles = &True
 	tasksOps.IncludeCompletedSubtasks = &True
@@ -41,13 +45,22 @@ func ExampleConnection_GetTasks() {
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
 	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetTasks
+	// 1. Task List Name: Design
+	// 1. Task ID: 4486838
+	// 1. Task Content: Draft the wireframes
+	// 1. Task Status: new
+	// on page #: 1
+	// # of pages: 1
+	// # of records: 3
 }
 
 func ExampleConnection_GetProjectTasks() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetProjectTasks")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -77,13 +90,22 @@ func ExampleConnection_GetProjectTasks() {
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
 	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetProjectTasks
+	// 1. Task List Name: Design
+	// 1. Task ID: 4486838
+	// 1. Task Content: Draft the wireframes
+	// 1. Task Status: new
+	// on page #: 1
+	// # of pages: 1
+	// # of records: 3
 }
 
 func ExampleConnection_GetTaskListTasks() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetTaskListTasks")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -113,13 +135,22 @@ func ExampleConnection_GetTaskListTasks() {
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
 	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetTaskListTasks
+	// 1. Task List Name: Design
+	// 1. Task ID: 4486838
+	// 1. Task Content: Draft the wireframes
+	// 1. Task Status: new
+	// on page #: 1
+	// # of pages: 1
+	// # of records: 2
 }
 
 func ExampleConnection_GetProjectTaskLists() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetProjectTaskLists")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -142,4 +173,270 @@ func ExampleConnection_GetProjectTaskLists() {
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
 	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetProjectTaskLists
+	// 1. Task Lists Name: Design
+	// 1. Task Lists ID: 704748
+	// on page #: 1
+	// # of pages: 1
+	// # of records: 2
+}
+
+func ExampleConnection_GetAllProjectTaskLists() {
+	// a stand-in for TeamWork with 5 pages of 1 task list
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		if r.URL.Path != "/projects/158721/tasklists.json" {
+			http.NotFound(w, r)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		w.Header().Set("X-Page", page)
+		w.Header().Set("X-Pages", "5")
+		w.Header().Set("X-Records", "5")
+		fmt.Fprintf(w, `{"tasklists": [{"id": "%s"}], "STATUS": "OK"}`, page)
+	}))
+	defer server.Close()
+
+	// setup the teamwork connection to fetch up to 3 pages at a time
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken",
+		teamwork.WithPageConcurrency(3),
+	)
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// the task lists are returned in page order
+	taskLists, err := conn.GetAllProjectTaskLists("158721", &teamwork.GetProjectTaskListsOps{})
+	if err != nil {
+		fmt.Printf("Error getting Project Task Lists: %s", err.Error())
+	}
+	for _, taskList := range taskLists {
+		fmt.Println("Task List:", taskList.ID)
+	}
+	// Output:
+	// Task List: 1
+	// Task List: 2
+	// Task List: 3
+	// Task List: 4
+	// Task List: 5
+}
+
+func ExampleConnection_CreateTask() {
+	// a fake TeamWork with a couple of task lists
+	server := teamworktest.NewServer()
+	defer server.Close()
+	server.AddTaskLists(
+		teamwork.TaskList{ID: "704748", Name: "Design", ProjectID: "158721"},
+		teamwork.TaskList{ID: "704749", Name: "Build", ProjectID: "158721"},
+	)
+
+	// setup the teamwork connection
+	conn, err := server.Connect()
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// create a task with a sub task
+	estimate := 90
+	createResponse, err := conn.CreateTask("704748", &teamwork.CreateTaskOps{
+		Content:          "Fix the login redirect",
+		Priority:         "high",
+		EstimatedMinutes: &estimate,
+	})
+	if err != nil {
+		fmt.Printf("Error creating Task: %s", err.Error())
+		os.Exit(1)
+	}
+	_, err = conn.CreateSubTask(createResponse.ID.String(), &teamwork.CreateTaskOps{Content: "Write a regression test"})
+	if err != nil {
+		fmt.Printf("Error creating Sub Task: %s", err.Error())
+	}
+
+	// move the task to another list and complete it
+	if _, err = conn.MoveTask(createResponse.ID.String(), "704749"); err != nil {
+		fmt.Printf("Error moving Task: %s", err.Error())
+	}
+	if _, err = conn.CompleteTask(createResponse.ID.String()); err != nil {
+		fmt.Printf("Error completing Task: %s", err.Error())
+	}
+
+	tasks, _, err := conn.GetProjectTasks("158721", &teamwork.GetTasksOps{})
+	if err != nil {
+		fmt.Printf("Error getting Tasks: %s", err.Error())
+	}
+	for _, task := range tasks {
+		fmt.Printf("%s (%s, %s)\n", task.Content, task.TaskListName, task.Status)
+	}
+	// Output:
+	// Fix the login redirect (Build, completed)
+	// Write a regression test (Design, new)
+}
+
+func TestTaskRequests(t *testing.T) {
+	conn := connectTo(t,
+		apiCall{
+			method:   "POST",
+			path:     "/tasklists/704748/tasks.json",
+			body:     `{"todo-item": {"content": "Fix the login redirect", "responsible-party-id": "85457,85458", "due-date": "20201016", "estimated-minutes": 90, "priority": "high"}}`,
+			response: `{"id": "4486900", "STATUS": "OK"}`,
+		},
+		apiCall{
+			method:   "POST",
+			path:     "/tasks/4486900.json",
+			body:     `{"todo-item": {"content": "Write a regression test"}}`,
+			response: `{"id": "4486901", "STATUS": "OK"}`,
+		},
+		apiCall{method: "PUT", path: "/tasks/4486900.json", body: `{"todo-item": {"progress": 0, "private": false}}`},
+		apiCall{method: "PUT", path: "/tasks/4486900.json", body: `{"todo-item": {"tasklistId": "704749"}}`},
+		apiCall{method: "PUT", path: "/tasks/4486900/complete.json"},
+		apiCall{method: "PUT", path: "/tasks/4486900/uncomplete.json"},
+		apiCall{method: "DELETE", path: "/tasks/4486901.json"},
+	)
+
+	estimate := 90
+	createResponse, err := conn.CreateTask("704748", &teamwork.CreateTaskOps{
+		Content:            "Fix the login redirect",
+		ResponsiblePartyID: "85457,85458",
+		DueDate:            "20201016",
+		EstimatedMinutes:   &estimate,
+		Priority:           "high",
+	})
+	if err != nil || createResponse.ID != "4486900" {
+		t.Fatalf("CreateTask() = %+v, %v, want 4486900", createResponse, err)
+	}
+	subTaskResponse, err := conn.CreateSubTask("4486900", &teamwork.CreateTaskOps{Content: "Write a regression test"})
+	if err != nil || subTaskResponse.ID != "4486901" {
+		t.Errorf("CreateSubTask() = %+v, %v, want 4486901", subTaskResponse, err)
+	}
+	progress, private := 0, false
+	if _, err := conn.UpdateTask("4486900", &teamwork.UpdateTaskOps{Progress: &progress, Private: &private}); err != nil {
+		t.Errorf("UpdateTask() error = %v", err)
+	}
+	if _, err := conn.MoveTask("4486900", "704749"); err != nil {
+		t.Errorf("MoveTask() error = %v", err)
+	}
+	if _, err := conn.CompleteTask("4486900"); err != nil {
+		t.Errorf("CompleteTask() error = %v", err)
+	}
+	if _, err := conn.UncompleteTask("4486900"); err != nil {
+		t.Errorf("UncompleteTask() error = %v", err)
+	}
+	if _, err := conn.DeleteTask("4486901"); err != nil {
+		t.Errorf("DeleteTask() error = %v", err)
+	}
+}
+
+func ExampleConnection_CreateTaskListFromTemplate() {
+	// a fake TeamWork with a sprint checklist template
+	server := teamworktest.NewServer()
+	defer server.Close()
+	server.AddProjects(teamwork.Project{ID: "158721", Name: "Website Redesign"})
+	server.AddTaskLists(teamwork.TaskList{ID: "700001", Name: "Sprint Checklist", IsTemplate: true})
+	server.AddTasks(
+		teamwork.Task{ID: "800001", Content: "Groom the backlog", TaskListID: "700001"},
+		teamwork.Task{ID: "800002", Content: "Hold the retrospective", TaskListID: "700001"},
+	)
+
+	// setup the teamwork connection
+	conn, err := server.Connect()
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// stamp out the checklist for the next sprint
+	createResponse, err := conn.CreateTaskListFromTemplate("158721", &teamwork.CreateTaskListFromTemplateOps{
+		TemplateID: "700001",
+		Name:       "Sprint 12",
+		StartDate:  "20201012",
+	})
+	if err != nil {
+		fmt.Printf("Error creating Task List: %s", err.Error())
+		os.Exit(1)
+	}
+
+	taskList, err := conn.GetTaskList(createResponse.ID.String())
+	if err != nil {
+		fmt.Printf("Error getting Task List: %s", err.Error())
+	}
+	fmt.Println("Name:", taskList.Name)
+
+	tasks, _, err := conn.GetTaskListTasks(createResponse.ID.String(), &teamwork.GetTasksOps{})
+	if err != nil {
+		fmt.Printf("Error getting Tasks: %s", err.Error())
+	}
+	for _, task := range tasks {
+		fmt.Println(task.Content, "starts", task.StartDate)
+	}
+
+	// it is done when the sprint is over
+	if _, err = conn.CompleteTaskList(createResponse.ID.String()); err != nil {
+		fmt.Printf("Error completing Task List: %s", err.Error())
+	}
+	taskList, _ = conn.GetTaskList(createResponse.ID.String())
+	fmt.Println("Complete:", taskList.Complete)
+	// Output:
+	// Name: Sprint 12
+	// Groom the backlog starts 20201012
+	// Hold the retrospective starts 20201012
+	// Complete: true
+}
+
+func TestTaskListRequests(t *testing.T) {
+	conn := connectTo(t,
+		apiCall{
+			method:   "POST",
+			path:     "/projects/158721/tasklists.json",
+			body:     `{"todo-list": {"name": "QA", "milestone-id": "512345", "pinned": true}}`,
+			response: `{"TASKLISTID": "704750", "STATUS": "OK"}`,
+		},
+		apiCall{
+			method:   "POST",
+			path:     "/projects/158721/tasklists.json",
+			body:     `{"todo-list": {"todo-list-template-id": "700001", "name": "Sprint 12", "todo-list-template-start-date": "20201012", "todo-list-template-assignments": {"1": "85457"}}}`,
+			response: `{"TASKLISTID": "704751", "STATUS": "OK"}`,
+		},
+		apiCall{method: "PUT", path: "/tasklists/704750.json", body: `{"todo-list": {"description": "Release testing"}}`},
+		apiCall{
+			method: "PUT",
+			path:   "/projects/158721/tasklists/reorder.json",
+			body:   `{"todo-lists": {"todo-list": [{"id": "704751"}, {"id": "704750"}]}}`,
+		},
+		apiCall{method: "PUT", path: "/tasklists/704751/complete.json"},
+		apiCall{method: "DELETE", path: "/tasklists/704750.json"},
+	)
+
+	pinned := true
+	createResponse, err := conn.CreateTaskList("158721", &teamwork.CreateTaskListOps{Name: "QA", MilestoneID: "512345", Pinned: &pinned})
+	if err != nil || createResponse.ID != "704750" {
+		t.Fatalf("CreateTaskList() = %+v, %v, want 704750", createResponse, err)
+	}
+	templateResponse, err := conn.CreateTaskListFromTemplate("158721", &teamwork.CreateTaskListFromTemplateOps{
+		TemplateID:  "700001",
+		Name:        "Sprint 12",
+		StartDate:   "20201012",
+		Assignments: map[string]string{"1": "85457"},
+	})
+	if err != nil || templateResponse.ID != "704751" {
+		t.Errorf("CreateTaskListFromTemplate() = %+v, %v, want 704751", templateResponse, err)
+	}
+	if _, err := conn.UpdateTaskList("704750", &teamwork.UpdateTaskListOps{Description: "Release testing"}); err != nil {
+		t.Errorf("UpdateTaskList() error = %v", err)
+	}
+	if _, err := conn.ReorderTaskLists("158721", []string{"704751", "704750"}); err != nil {
+		t.Errorf("ReorderTaskLists() error = %v", err)
+	}
+	if _, err := conn.CompleteTaskList("704751"); err != nil {
+		t.Errorf("CompleteTaskList() error = %v", err)
+	}
+	if _, err := conn.DeleteTaskList("704750"); err != nil {
+		t.Errorf("DeleteTaskList() error = %v", err)
+	}
 }
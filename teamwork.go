@@ -1,16 +1,20 @@
 package teamwork
 
 import (
+	"bytes"
+	"context"
+	"encoding"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"path"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Connection is the established connection to TeamWork.
@@ -51,196 +55,395 @@ type Connection struct {
 		UserIsMemberOfOwnerCompany string `json:"userIsMemberOfOwnerCompany"`
 	} `json:"account"`
 	ApiToken string
+
+	client    *http.Client
+	timeout   time.Duration
+	userAgent string
+	baseURL   string
+	limiter   *Limiter
+	logger    *slog.Logger
+	debug     bool
+
+	retry      *retryTransport
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	pageWorkers int
 }
 
 // Connect is the starting point to using the TeamWork API.
 // This function returns a Connection which is used to query
-// TeamWork via other functions.
-func Connect(baseURL string, APIToken string) (*Connection, error) {
+// TeamWork via other functions.  The behaviour of the
+// Connection can be adjusted by passing any number of Option(s).
+func Connect(baseURL string, APIToken string, opts ...Option) (*Connection, error) {
+	return ConnectContext(context.Background(), baseURL, APIToken, opts...)
+}
+
+// ConnectContext is like Connect but uses ctx for authenticating.
+func ConnectContext(ctx context.Context, baseURL string, APIToken string, opts ...Option) (*Connection, error) {
 	method := "GET"
 
+	connection := &Connection{
+		ApiToken:   APIToken,
+		client:     &http.Client{},
+		maxRetries: 3,
+		minBackoff: 1 * time.Second,
+		maxBackoff: 30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(connection)
+	}
+
+	if connection.logger == nil {
+		if connection.debug {
+			connection.logger = debugLogger()
+		} else {
+			connection.logger = slog.New(discardHandler{})
+		}
+	}
+
+	// copy the client so we never modify one passed in with WithHTTPClient
+	client := *connection.client
+	if connection.timeout > 0 {
+		client.Timeout = connection.timeout
+	}
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	connection.retry = &retryTransport{
+		next:       next,
+		maxRetries: connection.maxRetries,
+		minBackoff: connection.minBackoff,
+		maxBackoff: connection.maxBackoff,
+		limiter:    connection.limiter,
+		logger:     connection.logger,
+	}
+	client.Transport = connection.retry
+	connection.client = &client
+
 	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
 	u.Path = path.Join(u.Path, "authenticate.json")
 	url := u.String()
-	// log.Println(url)
 
-	reader, _, err := request(APIToken, method, url, nil)
+	reader, _, err := connection.request(ctx, method, url, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer reader.Close()
 
-	connection := &Connection{
-		ApiToken: APIToken,
-	}
 	if err := json.NewDecoder(reader).Decode(connection); err != nil {
 		return nil, err
 	}
+	if connection.baseURL == "" {
+		connection.baseURL = connection.Account.Url
+	}
 	return connection, nil
 }
 
 // request is the base level function for calling the TeamWork API.
-func request(token, method, url string, body io.Reader) (io.ReadCloser, http.Header, error) {
-	client := &http.Client{}
-	req, err := http.NewRequest(method, url, body)
+// A non 2xx response is returned as an *APIError.
+// The ctx is used for the lifetime of the call, including reading the body.
+func (conn *Connection) request(ctx context.Context, method, url string, body io.Reader) (io.ReadCloser, http.Header, error) {
+	return conn.requestContent(ctx, method, url, "application/json", body)
+}
+
+// requestContent is like request but sends a body of the given contentType.
+func (conn *Connection) requestContent(ctx context.Context, method, url, contentType string, body io.Reader) (io.ReadCloser, http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		log.Println("NewRequest:", err)
+		conn.logger.Error("teamwork: failed to build request", "method", method, "url", url, "error", err)
 		return nil, nil, err
 	}
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	if strings.HasPrefix(token, "tkn.v1") {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", contentType)
+	if conn.userAgent != "" {
+		req.Header.Set("User-Agent", conn.userAgent)
+	}
+	if strings.HasPrefix(conn.ApiToken, "tkn.v1") {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", conn.ApiToken))
 	} else {
-		req.SetBasicAuth(token, "notused")
+		req.SetBasicAuth(conn.ApiToken, "notused")
 	}
 
-	// // Save a copy of this request for debugging.
-	// req_dump, err := httputil.DumpRequest(req, true)
-	// if err != nil {
-	// 	fmt.Println(err)
-	// }
-	// fmt.Println(string(req_dump))
+	if conn.debug {
+		conn.debugRequest(req)
+	}
 
+	client := conn.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	start := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
-		log.Println("Do:", err)
+		conn.logger.Error("teamwork: request failed", "method", method, "url", url, "error", err)
 		return nil, nil, err
 	}
+	conn.logger.Debug("teamwork: request", "method", method, "url", url, "status", resp.StatusCode, "duration", time.Since(start))
+	setResponseMeta(ctx, resp)
 
-	// // Save a copy of this response for debugging.
-	// resp_dump, err := httputil.DumpResponse(resp, true)
-	// if err != nil {
-	// 	fmt.Println(err)
-	// }
-	// fmt.Println(string(resp_dump))
+	if conn.debug {
+		conn.debugResponse(resp)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		apiErr := newAPIError(resp)
+		conn.logger.Error("teamwork: request failed", "method", method, "url", url, "status", resp.StatusCode, "error", apiErr)
+		return nil, resp.Header, apiErr
+	}
 
 	return resp.Body, resp.Header, nil
 }
 
+// sendJSON calls the TeamWork API with the JSON encoding of body, if any,
+// and decodes the JSON response into response, if any.
+func (conn *Connection) sendJSON(ctx context.Context, method, url string, body, response interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(jsonBody)
+	}
+	respBody, _, err := conn.request(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	defer respBody.Close()
+
+	if response == nil {
+		return nil
+	}
+	if err := json.NewDecoder(respBody).Decode(response); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
 // buildParams takes a struct and builds query params based
 // on the `param:"paramName"` struct field tags.
 //
+// Empty strings and nil pointers are skipped.  Add `,omitempty` to the
+// tag (eg: `param:"userId,omitempty"`) to also skip zero values such as 0
+// and false.  Slices are sent as a comma separated list, time.Time is
+// formatted with the `format:"20060102"` struct field tag (default:
+// "20060102") and types implementing encoding.TextMarshaler or fmt.Stringer
+// are sent as their text.  Values are URL encoded.
+//
 // ref: https://play.golang.org/p/P9zvVJnMhR
 // ref: https://gist.github.com/drewolson/4771479
 func buildParams(ops interface{}) string {
-	pairs := make([]string, 0)
-	v := reflect.ValueOf(ops).Elem()
+	v := reflect.ValueOf(ops)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	params := url.Values{}
 	for i := 0; i < v.NumField(); i++ {
-		var paramValue string
-		paramName := v.Type().Field(i).Tag.Get("param") // get value from struct field tag
-
-		isPointer := false
-		var kind reflect.Kind
-		// Handle variable types
-		switch {
-		case v.Field(i).Kind() == reflect.Ptr:
-			kind = v.Field(i).Elem().Kind()
-			isPointer = true
-		case v.Field(i).Kind() == reflect.String:
-			paramValue = v.Field(i).Interface().(string)
-		case v.Field(i).Kind() == reflect.Bool:
-			paramValue = strconv.FormatBool(v.Field(i).Interface().(bool))
-		case v.Field(i).Kind() == reflect.Int:
-			paramValue = strconv.FormatInt(int64(v.Field(i).Interface().(int)), 10)
-		case v.Field(i).Kind() == reflect.Int8:
-			paramValue = strconv.FormatInt(int64(v.Field(i).Interface().(int8)), 10)
-		case v.Field(i).Kind() == reflect.Int16:
-			paramValue = strconv.FormatInt(int64(v.Field(i).Interface().(int16)), 10)
-		case v.Field(i).Kind() == reflect.Int32:
-			paramValue = strconv.FormatInt(int64(v.Field(i).Interface().(int32)), 10)
-		case v.Field(i).Kind() == reflect.Int64:
-			paramValue = strconv.FormatInt(v.Field(i).Interface().(int64), 10)
-		case v.Field(i).Kind() == reflect.Float32:
-			paramValue = strconv.FormatFloat(float64(v.Field(i).Interface().(float32)), 'f', -1, 64)
-		case v.Field(i).Kind() == reflect.Float64:
-			paramValue = strconv.FormatFloat(v.Field(i).Interface().(float64), 'f', -1, 64)
-		}
-
-		// handle pointers
-		switch {
-		case isPointer && kind == reflect.String:
-			if v.Field(i).Interface() != nil {
-				paramValue = *v.Field(i).Interface().(*string)
-			}
-		case isPointer && kind == reflect.Bool:
-			if v.Field(i).Interface() != nil {
-				paramValue = strconv.FormatBool(*v.Field(i).Interface().(*bool))
-			}
-		case isPointer && kind == reflect.Int:
-			if v.Field(i).Interface() != nil {
-				paramValue = strconv.FormatInt(int64(*v.Field(i).Interface().(*int)), 10)
-			}
-		case isPointer && kind == reflect.Int8:
-			if v.Field(i).Interface() != nil {
-				paramValue = strconv.FormatInt(int64(*v.Field(i).Interface().(*int8)), 10)
-			}
-		case isPointer && kind == reflect.Int16:
-			if v.Field(i).Interface() != nil {
-				paramValue = strconv.FormatInt(int64(*v.Field(i).Interface().(*int16)), 10)
-			}
-		case isPointer && kind == reflect.Int32:
-			if v.Field(i).Interface() != nil {
-				paramValue = strconv.FormatInt(int64(*v.Field(i).Interface().(*int32)), 10)
-			}
-		case isPointer && kind == reflect.Int64:
-			if v.Field(i).Interface() != nil {
-				paramValue = strconv.FormatInt(*v.Field(i).Interface().(*int64), 10)
-			}
-		case isPointer && kind == reflect.Float32:
-			if v.Field(i).Interface() != nil {
-				paramValue = strconv.FormatFloat(float64(*v.Field(i).Interface().(*float32)), 'f', -1, 64)
+		field := v.Type().Field(i)
+		paramName, tagOps, _ := strings.Cut(field.Tag.Get("param"), ",") // get value from struct field tag
+		if paramName == "" || paramName == "-" {
+			continue
+		}
+		omitEmpty := tagOps == "omitempty"
+		if paramValue, ok := formatParam(v.Field(i), field.Tag.Get("format"), omitEmpty); ok {
+			params.Set(paramName, paramValue)
+		}
+	}
+	if len(params) > 0 {
+		return fmt.Sprintf("?%s", params.Encode()) // return the params with the leading '?'
+	}
+	return "" // nothing to send back
+}
+
+// formatParam formats the value of a struct field for buildParams.
+// It returns false when the field should not be sent.
+func formatParam(field reflect.Value, format string, omitEmpty bool) (string, bool) {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return "", false
+		}
+		field = field.Elem()
+	} else if omitEmpty && field.IsZero() {
+		return "", false
+	}
+
+	if field.CanInterface() {
+		value := field.Interface()
+		if field.CanAddr() {
+			if _, ok := value.(encoding.TextMarshaler); !ok {
+				value = field.Addr().Interface() // pick up pointer receivers
 			}
-		case isPointer && kind == reflect.Float64:
-			if v.Field(i).Interface() != nil {
-				paramValue = strconv.FormatFloat(*v.Field(i).Interface().(*float64), 'f', -1, 64)
+		}
+		switch value := value.(type) {
+		case time.Time:
+			return formatTimeParam(value, format)
+		case *time.Time:
+			return formatTimeParam(*value, format)
+		case encoding.TextMarshaler:
+			text, err := value.MarshalText()
+			if err != nil {
+				return "", false
 			}
+			return string(text), len(text) > 0
+		case fmt.Stringer:
+			text := value.String()
+			return text, text != ""
 		}
-		if paramName != "" && paramValue != "" { // make sure we have what we need to set a param
-			pair := fmt.Sprintf("%s=%s", paramName, paramValue)
-			pairs = append(pairs, pair) // add to the param pairs array
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		return field.String(), field.String() != ""
+	case reflect.Bool:
+		return strconv.FormatBool(field.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(field.Uint(), 10), true
+	case reflect.Float32:
+		return strconv.FormatFloat(field.Float(), 'f', -1, 32), true
+	case reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'f', -1, 64), true
+	case reflect.Slice, reflect.Array:
+		values := make([]string, 0, field.Len())
+		for i := 0; i < field.Len(); i++ {
+			if value, ok := formatParam(field.Index(i), format, false); ok {
+				values = append(values, value)
+			}
 		}
+		return strings.Join(values, ","), len(values) > 0
 	}
-	if len(pairs) > 0 {
-		return fmt.Sprintf("?%s", strings.Join(pairs, "&")) // return the params with the leading '?'
-	} else {
-		return "" // nothing to send back
+	return "", false
+}
+
+// formatTimeParam formats a time.Time for buildParams.  Zero times are not sent.
+func formatTimeParam(t time.Time, format string) (string, bool) {
+	if t.IsZero() {
+		return "", false
+	}
+	if format == "" {
+		format = "20060102"
 	}
+	return t.Format(format), true
 }
 
 // getHeaders takes the response headers and populates
 // a struct of data according to the `header:"HeaderName"`.
-// Function currently only supports Int and String field types.
+// Supports string, bool, int, uint, float, time.Time and
+// time.Duration field types.  A time.Time is parsed with the
+// `format:"layout"` struct field tag (default: the HTTP date
+// formats) and a time.Duration is parsed as a number of seconds
+// or a Go duration (eg: "1m30s").  A http.Header field tagged
+// `header:"*"` is set to all the headers.
+//
+// All the fields which can be parsed are set, and an error is
+// returned for the first header which could not be parsed.
 //
 // ref: https://play.golang.org/p/P9zvVJnMhR
 // ref: https://gist.github.com/drewolson/4771479
 // ref: http://stackoverflow.com/a/6396678/977216
-func getHeaders(headers http.Header, obj interface{}) {
+func getHeaders(headers http.Header, obj interface{}) error {
 	v := reflect.ValueOf(obj).Elem()
-	if v.Kind() == reflect.Struct { // make sure we have a struct
-		for i := 0; i < v.NumField(); i++ { // for all fields
-			field := v.Field(i)                    // value field.
-			if field.IsValid() && field.CanSet() { // is exported and addressable
-				headerName := v.Type().Field(i).Tag.Get("header") // get value from struct field tag
-				if headerName != "" {                             // make sure the header is set
-					headerVal := headers.Get(headerName)
-					if headerVal != "" { // make sure we have a value in the header
-						switch {
-						case field.Kind() == reflect.Int: // Int struct field type
-							hVal, err := strconv.ParseInt(headerVal, 10, 64)
-							if err != nil {
-								log.Printf("Failed to convert header '%s' to a 64 bit Int. \n%s", headerName, err.Error())
-								continue
-							}
-							if !field.OverflowInt(hVal) {
-								field.SetInt(hVal)
-							}
-						case field.Kind() == reflect.String: // String struct field type
-							field.SetString(headerVal)
-						}
-					}
-				}
+	if v.Kind() != reflect.Struct { // make sure we have a struct
+		return fmt.Errorf("teamwork: getHeaders requires a pointer to a struct, got %T", obj)
+	}
+	var firstErr error
+	for i := 0; i < v.NumField(); i++ { // for all fields
+		field := v.Field(i)                      // value field.
+		if !field.IsValid() || !field.CanSet() { // is exported and addressable
+			continue
+		}
+		headerName := v.Type().Field(i).Tag.Get("header") // get value from struct field tag
+		if headerName == "" {                             // make sure the header is set
+			continue
+		}
+		if headerName == "*" {
+			if field.Type() == reflect.TypeOf(http.Header{}) {
+				field.Set(reflect.ValueOf(headers.Clone()))
 			}
+			continue
+		}
+		headerVal := headers.Get(headerName)
+		if headerVal == "" { // make sure we have a value in the header
+			continue
+		}
+		if err := setHeaderField(field, headerVal, v.Type().Field(i).Tag.Get("format")); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("teamwork: failed to parse header '%s': %w", headerName, err)
+		}
+	}
+	return firstErr
+}
+
+// setHeaderField sets a struct field from the value of a header.
+func setHeaderField(field reflect.Value, headerVal, format string) error {
+	switch field.Interface().(type) {
+	case time.Time:
+		var t time.Time
+		var err error
+		if format != "" {
+			t, err = time.Parse(format, headerVal)
+		} else {
+			t, err = http.ParseTime(headerVal)
+		}
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	case time.Duration:
+		if seconds, err := strconv.ParseFloat(headerVal, 64); err == nil {
+			field.SetInt(int64(seconds * float64(time.Second)))
+			return nil
+		}
+		d, err := time.ParseDuration(headerVal)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(headerVal)
+	case reflect.Bool:
+		hVal, err := strconv.ParseBool(headerVal)
+		if err != nil {
+			return err
+		}
+		field.SetBool(hVal)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		hVal, err := strconv.ParseInt(headerVal, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(hVal)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		hVal, err := strconv.ParseUint(headerVal, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(hVal)
+	case reflect.Float32, reflect.Float64:
+		hVal, err := strconv.ParseFloat(headerVal, field.Type().Bits())
+		if err != nil {
+			return err
 		}
+		field.SetFloat(hVal)
 	}
+	return nil
 }
@@ -0,0 +1,167 @@
+package teamwork
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildParams(t *testing.T) {
+	True := true
+	Page := 2
+	tests := []struct {
+		name string
+		ops  interface{}
+		want string
+	}{
+		{
+			name: "nil ops",
+			ops:  (*GetPeopleOps)(nil),
+			want: "",
+		},
+		{
+			name: "empty ops",
+			ops:  &GetPeopleOps{},
+			want: "",
+		},
+		{
+			name: "escaped values",
+			ops: &GetPeopleOps{
+				EmailAddress: "jane+work@example.com",
+				FullProfile:  &True,
+				Page:         &Page,
+			},
+			want: "?emailaddress=jane%2Bwork%40example.com&fullprofile=true&page=2",
+		},
+		{
+			name: "omitempty",
+			ops:  &GetTimeEntriesOps{SortOrder: "ASC"},
+			want: "?sortorder=ASC",
+		},
+		{
+			name: "richer types",
+			ops: &struct {
+				IDs      []int      `param:"ids"`
+				Names    []string   `param:"names"`
+				Empty    []string   `param:"empty"`
+				Date     time.Time  `param:"date"`
+				DateTime time.Time  `param:"datetime" format:"20060102150405"`
+				Zero     time.Time  `param:"zero"`
+				Pointer  *time.Time `param:"pointer" format:"15:04"`
+				IP       net.IP     `param:"ip"`
+				Duration time.Duration
+				Count    int `param:"count"`
+				Skipped  int `param:"skipped,omitempty"`
+			}{
+				IDs:      []int{32, 55},
+				Names:    []string{"a", "b c"},
+				Date:     time.Date(2020, 6, 3, 15, 21, 0, 0, time.UTC),
+				DateTime: time.Date(2020, 6, 3, 15, 21, 0, 0, time.UTC),
+				Pointer:  &time.Time{},
+				IP:       net.IPv4(127, 0, 0, 1),
+				Duration: time.Hour,
+			},
+			want: "?count=0&date=20200603&datetime=20200603152100&ids=32%2C55&ip=127.0.0.1&names=a%2Cb+c",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := buildParams(test.ops); got != test.want {
+				t.Errorf("buildParams() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGetHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Page", "2")
+	headers.Set("X-Pages", "5")
+	headers.Set("X-Records", "1200")
+	headers.Set("X-RateLimit-Remaining", "149")
+	headers.Set("X-Request-Id", "abc-123")
+
+	pages := &Pages{}
+	if err := getHeaders(headers, pages); err != nil {
+		t.Fatalf("getHeaders() error = %v", err)
+	}
+	// Pages stays comparable
+	wantPages := Pages{Page: 2, Pages: 5, Records: 1200, RateLimitRemaining: 149, RequestID: "abc-123"}
+	if *pages != wantPages {
+		t.Errorf("getHeaders() pages = %+v, want %+v", *pages, wantPages)
+	}
+	meta := &ResponseMeta{}
+	if err := getHeaders(headers, meta); err != nil || meta.Header.Get("X-Records") != "1200" {
+		t.Errorf("getHeaders() did not set the raw headers: %v", err)
+	}
+
+	headers = http.Header{}
+	headers.Set("X-Bool", "true")
+	headers.Set("X-Int64", "9000000000")
+	headers.Set("X-Float", "1.5")
+	headers.Set("X-Date", "Wed, 03 Jun 2020 15:21:00 GMT")
+	headers.Set("X-Day", "20200603")
+	headers.Set("X-Seconds", "90")
+	headers.Set("X-Duration", "1m30s")
+	headers.Set("X-Bad", "many")
+	headers.Set("X-After", "after")
+	types := &struct {
+		Bool     bool          `header:"X-Bool"`
+		Int64    int64         `header:"X-Int64"`
+		Float    float64       `header:"X-Float"`
+		Date     time.Time     `header:"X-Date"`
+		Day      time.Time     `header:"X-Day" format:"20060102"`
+		Seconds  time.Duration `header:"X-Seconds"`
+		Duration time.Duration `header:"X-Duration"`
+		Bad      int           `header:"X-Bad"`
+		After    string        `header:"X-After"`
+	}{}
+	err := getHeaders(headers, types)
+	if err == nil || !strings.Contains(err.Error(), "X-Bad") {
+		t.Errorf("getHeaders() error = %v, want an error for X-Bad", err)
+	}
+	want := time.Date(2020, 6, 3, 15, 21, 0, 0, time.UTC)
+	if !types.Bool || types.Int64 != 9000000000 || types.Float != 1.5 || !types.Date.Equal(want) {
+		t.Errorf("getHeaders() = %+v", types)
+	}
+	if !types.Day.Equal(time.Date(2020, 6, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("getHeaders() day = %v", types.Day)
+	}
+	if types.Seconds != 90*time.Second || types.Duration != 90*time.Second {
+		t.Errorf("getHeaders() durations = %v, %v, want 1m30s", types.Seconds, types.Duration)
+	}
+	if types.After != "after" {
+		t.Errorf("getHeaders() stopped at the first error")
+	}
+}
+
+func TestSumDuration(t *testing.T) {
+	tests := []struct {
+		hours, minutes string
+		want           time.Duration
+	}{
+		{"3.50", "210", 3*time.Hour + 30*time.Minute},
+		{"3.50", "", 3*time.Hour + 30*time.Minute},
+		{"", "95", 95 * time.Minute},
+		{"0.33", "", 20 * time.Minute},
+		{"", "", 0},
+		{"lots", "", 0},
+	}
+	for _, test := range tests {
+		if got := sumDuration(test.hours, test.minutes); got != test.want {
+			t.Errorf("sumDuration(%q, %q) = %v, want %v", test.hours, test.minutes, got, test.want)
+		}
+	}
+}
+
+func TestLimiterCancel(t *testing.T) {
+	l := NewLimiter(60, 2)
+	l.reserve()
+	l.cancel()
+	l.cancel()
+	if l.tokens != l.burst {
+		t.Errorf("tokens after cancel = %v, want at most the burst of %v", l.tokens, l.burst)
+	}
+}
@@ -1,20 +1,328 @@
 package teamwork_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
 
 	"github.com/swill/teamwork"
 )
 
 func ExampleConnect() {
-	// setup the teamwork connection
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
+	apiToken := "a_teamwork_apiToken"
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnect")))
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+	fmt.Println("Account:", conn.Account.Name)
+	fmt.Println("URL:", conn.Account.Url)
+	// Output:
+	// Account: teamworktest
+	// URL: https://example.teamwork.com/
+}
+
+func ExampleConnect_options() {
+	// setup the teamwork connection with a custom http client
 	baseURL := "a teamwork baseURL"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken,
+		teamwork.WithHTTPClient(&http.Client{Transport: http.DefaultTransport}),
+		teamwork.WithTimeout(30*time.Second),
+		teamwork.WithUserAgent("my-teamwork-tool/1.0"),
+	)
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
 	}
 	fmt.Printf("%+v", conn) // this is just so the go linter doesn't complain about "conn defined and not used"
 }
+
+// countingTransport counts the requests sent through it.
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestConnectOptions(t *testing.T) {
+	var paths, userAgents []string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		switch r.URL.Path {
+		case "/authenticate.json":
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+		case "/slow/projects.json":
+			time.Sleep(200 * time.Millisecond)
+			fmt.Fprint(w, `{"projects": [], "STATUS": "OK"}`)
+		default:
+			fmt.Fprint(w, `{"projects": [], "STATUS": "OK"}`)
+		}
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{}
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken",
+		teamwork.WithHTTPClient(&http.Client{Transport: transport}),
+		teamwork.WithUserAgent("my-teamwork-tool/1.0"),
+		teamwork.WithBaseURL(server.URL+"/api"),
+	)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	if _, _, err := conn.GetProjects(nil); err != nil {
+		t.Fatalf("GetProjects() error = %v", err)
+	}
+	if got, want := strings.Join(paths, ","), "/authenticate.json,/api/projects.json"; got != want {
+		t.Errorf("request paths = %s, want %s", got, want)
+	}
+	for _, userAgent := range userAgents {
+		if userAgent != "my-teamwork-tool/1.0" {
+			t.Errorf("User-Agent = %q, want my-teamwork-tool/1.0", userAgent)
+		}
+	}
+	if transport.requests != 2 {
+		t.Errorf("custom client sent %d requests, want 2", transport.requests)
+	}
+
+	slow, err := teamwork.Connect(server.URL, "a_teamwork_apiToken",
+		teamwork.WithTimeout(50*time.Millisecond),
+		teamwork.WithBaseURL(server.URL+"/slow"),
+		teamwork.WithRetries(0),
+	)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	_, _, err = slow.GetProjects(nil)
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Errorf("GetProjects() past the timeout error = %v, want a timeout", err)
+	}
+}
+
+// apiCall is a call the client is expected to make to TeamWork, and the
+// response TeamWork sends back.
+type apiCall struct {
+	method string
+	path   string
+	// The query the call must have, if any.
+	query string
+	// The JSON body the call must send, compared after decoding.  Empty
+	// for a call without a body.
+	body string
+	// The response body, or a STATUS of OK.
+	response string
+}
+
+// connectTo connects to a stand-in for TeamWork which expects exactly the
+// calls passed in, in order, and fails t on any call which differs from
+// them in its method, path, query or body.
+func connectTo(t *testing.T, calls ...apiCall) *teamwork.Connection {
+	t.Helper()
+	var mu sync.Mutex
+	next := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if next >= len(calls) {
+			t.Errorf("unexpected call %s %s", r.Method, r.URL)
+			http.Error(w, `{"MESSAGE": "unexpected call", "STATUS": "Error"}`, http.StatusBadRequest)
+			return
+		}
+		call := calls[next]
+		next++
+		if r.Method != call.method || r.URL.Path != call.path {
+			t.Errorf("call %d = %s %s, want %s %s", next, r.Method, r.URL.Path, call.method, call.path)
+		}
+		if call.query != "" && r.URL.RawQuery != call.query {
+			t.Errorf("call %d query = %s, want %s", next, r.URL.RawQuery, call.query)
+		}
+		data, _ := io.ReadAll(r.Body)
+		if !sameJSON(data, []byte(call.body)) {
+			t.Errorf("call %d body = %s, want %s", next, data, call.body)
+		}
+		if call.response == "" {
+			call.response = `{"STATUS": "OK"}`
+		}
+		fmt.Fprint(w, call.response)
+	}))
+	t.Cleanup(func() {
+		server.Close()
+		if next < len(calls) {
+			t.Errorf("made %d calls, want %d", next, len(calls))
+		}
+	})
+
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken", teamwork.WithRetries(0))
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	return conn
+}
+
+// connectToPages connects to a stand-in for TeamWork which serves pages
+// pages of one item under key at path, the item of each page having the
+// page number as its ID.  It fails t on a call to any other path.
+func connectToPages(t *testing.T, path, key string, pages int) *teamwork.Connection {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		if r.Method != "GET" || r.URL.Path != path {
+			t.Errorf("call = %s %s, want GET %s", r.Method, r.URL.Path, path)
+			http.NotFound(w, r)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		w.Header().Set("X-Page", page)
+		w.Header().Set("X-Pages", strconv.Itoa(pages))
+		w.Header().Set("X-Records", strconv.Itoa(pages))
+		fmt.Fprintf(w, `{%q: [{"id": %q}], "STATUS": "OK"}`, key, page)
+	}))
+	t.Cleanup(server.Close)
+
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken", teamwork.WithPageConcurrency(3))
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	return conn
+}
+
+// pageIDs returns the IDs of items as a comma separated list.
+func pageIDs[T any](items []T, id func(T) teamwork.ID) string {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = id(item).String()
+	}
+	return strings.Join(ids, ",")
+}
+
+// sameJSON reports whether a and b hold the same JSON value, or are both
+// empty.
+func sameJSON(a, b []byte) bool {
+	if len(bytes.TrimSpace(a)) == 0 || len(bytes.TrimSpace(b)) == 0 {
+		return len(bytes.TrimSpace(a)) == len(bytes.TrimSpace(b))
+	}
+	var x, y interface{}
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func ExampleWithResponseMeta() {
+	// a stand-in for TeamWork which sends a request ID with every response
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-"+strings.TrimSuffix(r.URL.Path[1:], ".json"))
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		w.Header().Set("X-Custom", "sent by TeamWork")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"MESSAGE": "Project not found", "STATUS": "Error"}`)
+	}))
+	defer server.Close()
+
+	// setup the teamwork connection
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken")
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// keep the metadata of the response, even when the call fails
+	meta := &teamwork.ResponseMeta{}
+	ctx := teamwork.WithResponseMeta(context.Background(), meta)
+	if _, err := conn.GetProjectContext(ctx, "1", nil); err != nil {
+		fmt.Println("Error:", err.(*teamwork.APIError).Message)
+	}
+	fmt.Println("Status Code:", meta.StatusCode)
+	fmt.Println("Request ID:", meta.RequestID)
+	fmt.Println("X-Custom:", meta.Header.Get("X-Custom"))
+	// Output:
+	// Error: Project not found
+	// Status Code: 404
+	// Request ID: req-projects/1
+	// X-Custom: sent by TeamWork
+}
+
+func TestResponseMetaWithPageConcurrency(t *testing.T) {
+	conn := connectToPages(t, "/time_entries.json", "time-entries", 10)
+	meta := &teamwork.ResponseMeta{}
+	ctx := teamwork.WithResponseMeta(context.Background(), meta)
+	timeEntries, err := conn.GetAllTimeEntriesContext(ctx, nil)
+	if err != nil || len(timeEntries) != 10 {
+		t.Fatalf("GetAllTimeEntriesContext() = %d, %v, want 10 time entries", len(timeEntries), err)
+	}
+	if meta.StatusCode != http.StatusOK || meta.Header.Get("X-Pages") != "10" {
+		t.Errorf("ResponseMeta = %d, %q, want 200, 10 pages", meta.StatusCode, meta.Header.Get("X-Pages"))
+	}
+}
+
+func TestListHeaders(t *testing.T) {
+	tests := []struct {
+		header, value string
+		wantErr       bool
+	}{
+		{"X-RateLimit-Limit", "lots", false},
+		{"X-RateLimit-Reset", "soon", false},
+		{"X-Pages", "many", true},
+	}
+	for _, test := range tests {
+		var server *httptest.Server
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/authenticate.json" {
+				fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+				return
+			}
+			w.Header().Set("X-Page", "1")
+			w.Header().Set(test.header, test.value)
+			fmt.Fprint(w, `{"projects": [{"id": "158721"}], "STATUS": "OK"}`)
+		}))
+		conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken")
+		if err != nil {
+			t.Fatalf("Connect() error = %v", err)
+		}
+		projects, pages, err := conn.GetProjects(nil)
+		server.Close()
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("GetProjects() with %s: %s error = nil, want an error", test.header, test.value)
+			}
+			continue
+		}
+		if err != nil || len(projects) != 1 || pages.Page != 1 {
+			t.Errorf("GetProjects() with %s: %s = %d projects, page %d, %v, want 1 project on page 1", test.header, test.value, len(projects), pages.Page, err)
+		}
+	}
+}
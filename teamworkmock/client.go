@@ -0,0 +1,1980 @@
+// Code generated by mockgen -iface Client; DO NOT EDIT.
+
+package teamworkmock
+
+import (
+	"context"
+	"io"
+	"iter"
+
+	"github.com/swill/teamwork"
+)
+
+// Client is a mock of teamwork.Client.  Set the ...Func field of each method the
+// code under test calls.  Calling a method whose ...Func is not set panics.
+type Client struct {
+	GetProjectsFunc                       func(ops *teamwork.GetProjectsOps) (teamwork.Projects, teamwork.Pages, error)
+	GetProjectsContextFunc                func(ctx context.Context, ops *teamwork.GetProjectsOps) (teamwork.Projects, teamwork.Pages, error)
+	AllProjectsFunc                       func(ctx context.Context, ops *teamwork.GetProjectsOps) iter.Seq2[teamwork.Project, error]
+	GetAllProjectsFunc                    func(ops *teamwork.GetProjectsOps) (teamwork.Projects, error)
+	GetAllProjectsContextFunc             func(ctx context.Context, ops *teamwork.GetProjectsOps) (teamwork.Projects, error)
+	GetProjectFunc                        func(id string, ops *teamwork.GetProjectOps) (teamwork.Project, error)
+	GetProjectContextFunc                 func(ctx context.Context, id string, ops *teamwork.GetProjectOps) (teamwork.Project, error)
+	CreateProjectFunc                     func(ops *teamwork.CreateProjectOps) (*teamwork.CreateProjectResponse, error)
+	CreateProjectContextFunc              func(ctx context.Context, ops *teamwork.CreateProjectOps) (*teamwork.CreateProjectResponse, error)
+	UpdateProjectFunc                     func(id string, ops *teamwork.UpdateProjectOps) (*teamwork.StatusResponse, error)
+	UpdateProjectContextFunc              func(ctx context.Context, id string, ops *teamwork.UpdateProjectOps) (*teamwork.StatusResponse, error)
+	DeleteProjectFunc                     func(id string) (*teamwork.StatusResponse, error)
+	DeleteProjectContextFunc              func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	ArchiveProjectFunc                    func(id string) (*teamwork.StatusResponse, error)
+	ArchiveProjectContextFunc             func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	ReactivateProjectFunc                 func(id string) (*teamwork.StatusResponse, error)
+	ReactivateProjectContextFunc          func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	StarProjectFunc                       func(id string) (*teamwork.StatusResponse, error)
+	StarProjectContextFunc                func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	UnstarProjectFunc                     func(id string) (*teamwork.StatusResponse, error)
+	UnstarProjectContextFunc              func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	AddPeopleToProjectFunc                func(projectID string, personIDs []string) (*teamwork.StatusResponse, error)
+	AddPeopleToProjectContextFunc         func(ctx context.Context, projectID string, personIDs []string) (*teamwork.StatusResponse, error)
+	RemovePersonFromProjectFunc           func(projectID string, personID string) (*teamwork.StatusResponse, error)
+	RemovePersonFromProjectContextFunc    func(ctx context.Context, projectID string, personID string) (*teamwork.StatusResponse, error)
+	SetProjectPeopleFunc                  func(projectID string, personIDs []string) (*teamwork.StatusResponse, error)
+	SetProjectPeopleContextFunc           func(ctx context.Context, projectID string, personIDs []string) (*teamwork.StatusResponse, error)
+	UpdateProjectPermissionsFunc          func(projectID string, personID string, perms *teamwork.ProjectPermissions) (*teamwork.StatusResponse, error)
+	UpdateProjectPermissionsContextFunc   func(ctx context.Context, projectID string, personID string, perms *teamwork.ProjectPermissions) (*teamwork.StatusResponse, error)
+	GetPeopleFunc                         func(ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error)
+	GetPeopleContextFunc                  func(ctx context.Context, ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error)
+	AllPeopleFunc                         func(ctx context.Context, ops *teamwork.GetPeopleOps) iter.Seq2[teamwork.Person, error]
+	GetAllPeopleFunc                      func(ops *teamwork.GetPeopleOps) (teamwork.People, error)
+	GetAllPeopleContextFunc               func(ctx context.Context, ops *teamwork.GetPeopleOps) (teamwork.People, error)
+	GetProjectPeopleFunc                  func(id string, ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error)
+	GetProjectPeopleContextFunc           func(ctx context.Context, id string, ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error)
+	AllProjectPeopleFunc                  func(ctx context.Context, id string, ops *teamwork.GetPeopleOps) iter.Seq2[teamwork.Person, error]
+	GetAllProjectPeopleFunc               func(id string, ops *teamwork.GetPeopleOps) (teamwork.People, error)
+	GetAllProjectPeopleContextFunc        func(ctx context.Context, id string, ops *teamwork.GetPeopleOps) (teamwork.People, error)
+	GetCompanyPeopleFunc                  func(id string, ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error)
+	GetCompanyPeopleContextFunc           func(ctx context.Context, id string, ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error)
+	AllCompanyPeopleFunc                  func(ctx context.Context, id string, ops *teamwork.GetPeopleOps) iter.Seq2[teamwork.Person, error]
+	GetAllCompanyPeopleFunc               func(id string, ops *teamwork.GetPeopleOps) (teamwork.People, error)
+	GetAllCompanyPeopleContextFunc        func(ctx context.Context, id string, ops *teamwork.GetPeopleOps) (teamwork.People, error)
+	GetPersonFunc                         func(id string) (teamwork.Person, error)
+	GetPersonContextFunc                  func(ctx context.Context, id string) (teamwork.Person, error)
+	GetCurrentPersonFunc                  func() (teamwork.Person, error)
+	GetCurrentPersonContextFunc           func(ctx context.Context) (teamwork.Person, error)
+	CreatePersonFunc                      func(ops *teamwork.CreatePersonOps) (*teamwork.CreatePersonResponse, error)
+	CreatePersonContextFunc               func(ctx context.Context, ops *teamwork.CreatePersonOps) (*teamwork.CreatePersonResponse, error)
+	InvitePersonFunc                      func(ops *teamwork.CreatePersonOps) (*teamwork.CreatePersonResponse, error)
+	InvitePersonContextFunc               func(ctx context.Context, ops *teamwork.CreatePersonOps) (*teamwork.CreatePersonResponse, error)
+	UpdatePersonFunc                      func(id string, ops *teamwork.UpdatePersonOps) (*teamwork.StatusResponse, error)
+	UpdatePersonContextFunc               func(ctx context.Context, id string, ops *teamwork.UpdatePersonOps) (*teamwork.StatusResponse, error)
+	SetPersonAdministratorFunc            func(id string, administrator bool) (*teamwork.StatusResponse, error)
+	SetPersonAdministratorContextFunc     func(ctx context.Context, id string, administrator bool) (*teamwork.StatusResponse, error)
+	DeletePersonFunc                      func(id string) (*teamwork.StatusResponse, error)
+	DeletePersonContextFunc               func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	GetCompaniesFunc                      func(ops *teamwork.GetCompaniesOps) (teamwork.Companies, teamwork.Pages, error)
+	GetCompaniesContextFunc               func(ctx context.Context, ops *teamwork.GetCompaniesOps) (teamwork.Companies, teamwork.Pages, error)
+	AllCompaniesFunc                      func(ctx context.Context, ops *teamwork.GetCompaniesOps) iter.Seq2[teamwork.Company, error]
+	GetAllCompaniesFunc                   func(ops *teamwork.GetCompaniesOps) (teamwork.Companies, error)
+	GetAllCompaniesContextFunc            func(ctx context.Context, ops *teamwork.GetCompaniesOps) (teamwork.Companies, error)
+	GetCompanyFunc                        func(id string) (teamwork.Company, error)
+	GetCompanyContextFunc                 func(ctx context.Context, id string) (teamwork.Company, error)
+	GetCompanyProjectsFunc                func(id string, ops *teamwork.GetProjectsOps) (teamwork.Projects, teamwork.Pages, error)
+	GetCompanyProjectsContextFunc         func(ctx context.Context, id string, ops *teamwork.GetProjectsOps) (teamwork.Projects, teamwork.Pages, error)
+	AllCompanyProjectsFunc                func(ctx context.Context, id string, ops *teamwork.GetProjectsOps) iter.Seq2[teamwork.Project, error]
+	GetAllCompanyProjectsFunc             func(id string, ops *teamwork.GetProjectsOps) (teamwork.Projects, error)
+	GetAllCompanyProjectsContextFunc      func(ctx context.Context, id string, ops *teamwork.GetProjectsOps) (teamwork.Projects, error)
+	CreateCompanyFunc                     func(ops *teamwork.CreateCompanyOps) (*teamwork.CreateCompanyResponse, error)
+	CreateCompanyContextFunc              func(ctx context.Context, ops *teamwork.CreateCompanyOps) (*teamwork.CreateCompanyResponse, error)
+	UpdateCompanyFunc                     func(id string, ops *teamwork.UpdateCompanyOps) (*teamwork.StatusResponse, error)
+	UpdateCompanyContextFunc              func(ctx context.Context, id string, ops *teamwork.UpdateCompanyOps) (*teamwork.StatusResponse, error)
+	DeleteCompanyFunc                     func(id string) (*teamwork.StatusResponse, error)
+	DeleteCompanyContextFunc              func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	GetTasksFunc                          func(ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error)
+	GetTasksContextFunc                   func(ctx context.Context, ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error)
+	AllTasksFunc                          func(ctx context.Context, ops *teamwork.GetTasksOps) iter.Seq2[teamwork.Task, error]
+	GetAllTasksFunc                       func(ops *teamwork.GetTasksOps) (teamwork.Tasks, error)
+	GetAllTasksContextFunc                func(ctx context.Context, ops *teamwork.GetTasksOps) (teamwork.Tasks, error)
+	GetProjectTasksFunc                   func(id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error)
+	GetProjectTasksContextFunc            func(ctx context.Context, id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error)
+	AllProjectTasksFunc                   func(ctx context.Context, id string, ops *teamwork.GetTasksOps) iter.Seq2[teamwork.Task, error]
+	GetAllProjectTasksFunc                func(id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, error)
+	GetAllProjectTasksContextFunc         func(ctx context.Context, id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, error)
+	GetTaskListTasksFunc                  func(id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error)
+	GetTaskListTasksContextFunc           func(ctx context.Context, id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error)
+	AllTaskListTasksFunc                  func(ctx context.Context, id string, ops *teamwork.GetTasksOps) iter.Seq2[teamwork.Task, error]
+	GetAllTaskListTasksFunc               func(id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, error)
+	GetAllTaskListTasksContextFunc        func(ctx context.Context, id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, error)
+	CreateTaskFunc                        func(taskListID string, ops *teamwork.CreateTaskOps) (*teamwork.CreateTaskResponse, error)
+	CreateTaskContextFunc                 func(ctx context.Context, taskListID string, ops *teamwork.CreateTaskOps) (*teamwork.CreateTaskResponse, error)
+	CreateSubTaskFunc                     func(taskID string, ops *teamwork.CreateTaskOps) (*teamwork.CreateTaskResponse, error)
+	CreateSubTaskContextFunc              func(ctx context.Context, taskID string, ops *teamwork.CreateTaskOps) (*teamwork.CreateTaskResponse, error)
+	UpdateTaskFunc                        func(id string, ops *teamwork.UpdateTaskOps) (*teamwork.StatusResponse, error)
+	UpdateTaskContextFunc                 func(ctx context.Context, id string, ops *teamwork.UpdateTaskOps) (*teamwork.StatusResponse, error)
+	MoveTaskFunc                          func(id string, taskListID string) (*teamwork.StatusResponse, error)
+	MoveTaskContextFunc                   func(ctx context.Context, id string, taskListID string) (*teamwork.StatusResponse, error)
+	CompleteTaskFunc                      func(id string) (*teamwork.StatusResponse, error)
+	CompleteTaskContextFunc               func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	UncompleteTaskFunc                    func(id string) (*teamwork.StatusResponse, error)
+	UncompleteTaskContextFunc             func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	DeleteTaskFunc                        func(id string) (*teamwork.StatusResponse, error)
+	DeleteTaskContextFunc                 func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	GetProjectTaskListsFunc               func(id string, ops *teamwork.GetProjectTaskListsOps) (teamwork.TaskLists, teamwork.Pages, error)
+	GetProjectTaskListsContextFunc        func(ctx context.Context, id string, ops *teamwork.GetProjectTaskListsOps) (teamwork.TaskLists, teamwork.Pages, error)
+	AllProjectTaskListsFunc               func(ctx context.Context, id string, ops *teamwork.GetProjectTaskListsOps) iter.Seq2[teamwork.TaskList, error]
+	GetAllProjectTaskListsFunc            func(id string, ops *teamwork.GetProjectTaskListsOps) (teamwork.TaskLists, error)
+	GetAllProjectTaskListsContextFunc     func(ctx context.Context, id string, ops *teamwork.GetProjectTaskListsOps) (teamwork.TaskLists, error)
+	GetTaskListFunc                       func(id string) (teamwork.TaskList, error)
+	GetTaskListContextFunc                func(ctx context.Context, id string) (teamwork.TaskList, error)
+	CreateTaskListFunc                    func(projectID string, ops *teamwork.CreateTaskListOps) (*teamwork.CreateTaskListResponse, error)
+	CreateTaskListContextFunc             func(ctx context.Context, projectID string, ops *teamwork.CreateTaskListOps) (*teamwork.CreateTaskListResponse, error)
+	CreateTaskListFromTemplateFunc        func(projectID string, ops *teamwork.CreateTaskListFromTemplateOps) (*teamwork.CreateTaskListResponse, error)
+	CreateTaskListFromTemplateContextFunc func(ctx context.Context, projectID string, ops *teamwork.CreateTaskListFromTemplateOps) (*teamwork.CreateTaskListResponse, error)
+	UpdateTaskListFunc                    func(id string, ops *teamwork.UpdateTaskListOps) (*teamwork.StatusResponse, error)
+	UpdateTaskListContextFunc             func(ctx context.Context, id string, ops *teamwork.UpdateTaskListOps) (*teamwork.StatusResponse, error)
+	DeleteTaskListFunc                    func(id string) (*teamwork.StatusResponse, error)
+	DeleteTaskListContextFunc             func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	CompleteTaskListFunc                  func(id string) (*teamwork.StatusResponse, error)
+	CompleteTaskListContextFunc           func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	ReorderTaskListsFunc                  func(projectID string, taskListIDs []string) (*teamwork.StatusResponse, error)
+	ReorderTaskListsContextFunc           func(ctx context.Context, projectID string, taskListIDs []string) (*teamwork.StatusResponse, error)
+	GetMilestonesFunc                     func(ops *teamwork.GetMilestonesOps) (teamwork.Milestones, teamwork.Pages, error)
+	GetMilestonesContextFunc              func(ctx context.Context, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, teamwork.Pages, error)
+	AllMilestonesFunc                     func(ctx context.Context, ops *teamwork.GetMilestonesOps) iter.Seq2[teamwork.Milestone, error]
+	GetAllMilestonesFunc                  func(ops *teamwork.GetMilestonesOps) (teamwork.Milestones, error)
+	GetAllMilestonesContextFunc           func(ctx context.Context, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, error)
+	GetProjectMilestonesFunc              func(id string, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, teamwork.Pages, error)
+	GetProjectMilestonesContextFunc       func(ctx context.Context, id string, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, teamwork.Pages, error)
+	AllProjectMilestonesFunc              func(ctx context.Context, id string, ops *teamwork.GetMilestonesOps) iter.Seq2[teamwork.Milestone, error]
+	GetAllProjectMilestonesFunc           func(id string, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, error)
+	GetAllProjectMilestonesContextFunc    func(ctx context.Context, id string, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, error)
+	GetMilestoneFunc                      func(id string) (teamwork.Milestone, error)
+	GetMilestoneContextFunc               func(ctx context.Context, id string) (teamwork.Milestone, error)
+	CreateMilestoneFunc                   func(projectID string, ops *teamwork.CreateMilestoneOps) (*teamwork.CreateMilestoneResponse, error)
+	CreateMilestoneContextFunc            func(ctx context.Context, projectID string, ops *teamwork.CreateMilestoneOps) (*teamwork.CreateMilestoneResponse, error)
+	UpdateMilestoneFunc                   func(id string, ops *teamwork.UpdateMilestoneOps) (*teamwork.StatusResponse, error)
+	UpdateMilestoneContextFunc            func(ctx context.Context, id string, ops *teamwork.UpdateMilestoneOps) (*teamwork.StatusResponse, error)
+	CompleteMilestoneFunc                 func(id string) (*teamwork.StatusResponse, error)
+	CompleteMilestoneContextFunc          func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	UncompleteMilestoneFunc               func(id string) (*teamwork.StatusResponse, error)
+	UncompleteMilestoneContextFunc        func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	DeleteMilestoneFunc                   func(id string) (*teamwork.StatusResponse, error)
+	DeleteMilestoneContextFunc            func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	GetCommentsFunc                       func(resourceType string, id string, ops *teamwork.GetCommentsOps) (teamwork.Comments, teamwork.Pages, error)
+	GetCommentsContextFunc                func(ctx context.Context, resourceType string, id string, ops *teamwork.GetCommentsOps) (teamwork.Comments, teamwork.Pages, error)
+	AllCommentsFunc                       func(ctx context.Context, resourceType string, id string, ops *teamwork.GetCommentsOps) iter.Seq2[teamwork.Comment, error]
+	GetAllCommentsFunc                    func(resourceType string, id string, ops *teamwork.GetCommentsOps) (teamwork.Comments, error)
+	GetAllCommentsContextFunc             func(ctx context.Context, resourceType string, id string, ops *teamwork.GetCommentsOps) (teamwork.Comments, error)
+	CreateCommentFunc                     func(resourceType string, id string, ops *teamwork.CreateCommentOps) (*teamwork.CreateCommentResponse, error)
+	CreateCommentContextFunc              func(ctx context.Context, resourceType string, id string, ops *teamwork.CreateCommentOps) (*teamwork.CreateCommentResponse, error)
+	UpdateCommentFunc                     func(id string, ops *teamwork.UpdateCommentOps) (*teamwork.StatusResponse, error)
+	UpdateCommentContextFunc              func(ctx context.Context, id string, ops *teamwork.UpdateCommentOps) (*teamwork.StatusResponse, error)
+	DeleteCommentFunc                     func(id string) (*teamwork.StatusResponse, error)
+	DeleteCommentContextFunc              func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	MarkCommentReadFunc                   func(id string) (*teamwork.StatusResponse, error)
+	MarkCommentReadContextFunc            func(ctx context.Context, id string) (*teamwork.StatusResponse, error)
+	UploadPendingFileFunc                 func(filename string, file io.Reader) (teamwork.PendingFile, error)
+	UploadPendingFileContextFunc          func(ctx context.Context, filename string, file io.Reader) (teamwork.PendingFile, error)
+	GetTimeEntriesFunc                    func(ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error)
+	GetTimeEntriesContextFunc             func(ctx context.Context, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error)
+	AllTimeEntriesFunc                    func(ctx context.Context, ops *teamwork.GetTimeEntriesOps) iter.Seq2[teamwork.TimeEntry, error]
+	GetAllTimeEntriesFunc                 func(ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error)
+	GetAllTimeEntriesContextFunc          func(ctx context.Context, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error)
+	GetProjectTimeEntriesFunc             func(id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error)
+	GetProjectTimeEntriesContextFunc      func(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error)
+	AllProjectTimeEntriesFunc             func(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) iter.Seq2[teamwork.TimeEntry, error]
+	GetAllProjectTimeEntriesFunc          func(id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error)
+	GetAllProjectTimeEntriesContextFunc   func(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error)
+	CreateTimeEntryForProjectFunc         func(projectID string, ops *teamwork.CreateTimeEntryOps) (*teamwork.CreateTimeEntryResponse, error)
+	CreateTimeEntryForProjectContextFunc  func(ctx context.Context, projectID string, ops *teamwork.CreateTimeEntryOps) (*teamwork.CreateTimeEntryResponse, error)
+	CreateTimeEntryForTaskFunc            func(taskID string, ops *teamwork.CreateTimeEntryOps) (*teamwork.CreateTimeEntryResponse, error)
+	CreateTimeEntryForTaskContextFunc     func(ctx context.Context, taskID string, ops *teamwork.CreateTimeEntryOps) (*teamwork.CreateTimeEntryResponse, error)
+	GetTimeEntryFunc                      func(id string) (teamwork.TimeEntry, error)
+	GetTimeEntryContextFunc               func(ctx context.Context, id string) (teamwork.TimeEntry, error)
+	UpdateTimeEntryFunc                   func(id string, ops *teamwork.UpdateTimeEntryOps) (*teamwork.StatusResponse, error)
+	UpdateTimeEntryContextFunc            func(ctx context.Context, id string, ops *teamwork.UpdateTimeEntryOps) (*teamwork.StatusResponse, error)
+	DeleteTimeEntryFunc                   func(id string) (*teamwork.DeleteTimeEntryResponse, error)
+	DeleteTimeEntryContextFunc            func(ctx context.Context, id string) (*teamwork.DeleteTimeEntryResponse, error)
+	GetTaskTimeEntriesFunc                func(id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error)
+	GetTaskTimeEntriesContextFunc         func(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error)
+	AllTaskTimeEntriesFunc                func(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) iter.Seq2[teamwork.TimeEntry, error]
+	GetAllTaskTimeEntriesFunc             func(id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error)
+	GetAllTaskTimeEntriesContextFunc      func(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error)
+	GetTotalTimeFunc                      func(ops *teamwork.GetTotalTimeOps) (teamwork.TotalTime, error)
+	GetTotalTimeContextFunc               func(ctx context.Context, ops *teamwork.GetTotalTimeOps) (teamwork.TotalTime, error)
+	GetProjectTotalTimeFunc               func(id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTotalTimes, error)
+	GetProjectTotalTimeContextFunc        func(ctx context.Context, id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTotalTimes, error)
+	GetTaskListTotalTimeFunc              func(id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTaskListTotalTimes, error)
+	GetTaskListTotalTimeContextFunc       func(ctx context.Context, id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTaskListTotalTimes, error)
+	GetTaskTotalTimeFunc                  func(id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTaskTotalTimes, error)
+	GetTaskTotalTimeContextFunc           func(ctx context.Context, id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTaskTotalTimes, error)
+	RateLimitFunc                         func() teamwork.RateLimit
+
+	calls recorder
+}
+
+var _ teamwork.Client = (*Client)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (mock *Client) Calls() []Call {
+	return mock.calls.all()
+}
+
+// CallsTo returns the calls made to a method of the mock, in order.
+func (mock *Client) CallsTo(method string) []Call {
+	return mock.calls.to(method)
+}
+
+// GetProjects calls GetProjectsFunc.
+func (mock *Client) GetProjects(ops *teamwork.GetProjectsOps) (teamwork.Projects, teamwork.Pages, error) {
+	mock.calls.record("GetProjects", ops)
+	if mock.GetProjectsFunc == nil {
+		panic("teamworkmock: Client.GetProjectsFunc is not set")
+	}
+	return mock.GetProjectsFunc(ops)
+}
+
+// GetProjectsContext calls GetProjectsContextFunc.
+func (mock *Client) GetProjectsContext(ctx context.Context, ops *teamwork.GetProjectsOps) (teamwork.Projects, teamwork.Pages, error) {
+	mock.calls.record("GetProjectsContext", ctx, ops)
+	if mock.GetProjectsContextFunc == nil {
+		panic("teamworkmock: Client.GetProjectsContextFunc is not set")
+	}
+	return mock.GetProjectsContextFunc(ctx, ops)
+}
+
+// AllProjects calls AllProjectsFunc.
+func (mock *Client) AllProjects(ctx context.Context, ops *teamwork.GetProjectsOps) iter.Seq2[teamwork.Project, error] {
+	mock.calls.record("AllProjects", ctx, ops)
+	if mock.AllProjectsFunc == nil {
+		panic("teamworkmock: Client.AllProjectsFunc is not set")
+	}
+	return mock.AllProjectsFunc(ctx, ops)
+}
+
+// GetAllProjects calls GetAllProjectsFunc.
+func (mock *Client) GetAllProjects(ops *teamwork.GetProjectsOps) (teamwork.Projects, error) {
+	mock.calls.record("GetAllProjects", ops)
+	if mock.GetAllProjectsFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectsFunc is not set")
+	}
+	return mock.GetAllProjectsFunc(ops)
+}
+
+// GetAllProjectsContext calls GetAllProjectsContextFunc.
+func (mock *Client) GetAllProjectsContext(ctx context.Context, ops *teamwork.GetProjectsOps) (teamwork.Projects, error) {
+	mock.calls.record("GetAllProjectsContext", ctx, ops)
+	if mock.GetAllProjectsContextFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectsContextFunc is not set")
+	}
+	return mock.GetAllProjectsContextFunc(ctx, ops)
+}
+
+// GetProject calls GetProjectFunc.
+func (mock *Client) GetProject(id string, ops *teamwork.GetProjectOps) (teamwork.Project, error) {
+	mock.calls.record("GetProject", id, ops)
+	if mock.GetProjectFunc == nil {
+		panic("teamworkmock: Client.GetProjectFunc is not set")
+	}
+	return mock.GetProjectFunc(id, ops)
+}
+
+// GetProjectContext calls GetProjectContextFunc.
+func (mock *Client) GetProjectContext(ctx context.Context, id string, ops *teamwork.GetProjectOps) (teamwork.Project, error) {
+	mock.calls.record("GetProjectContext", ctx, id, ops)
+	if mock.GetProjectContextFunc == nil {
+		panic("teamworkmock: Client.GetProjectContextFunc is not set")
+	}
+	return mock.GetProjectContextFunc(ctx, id, ops)
+}
+
+// CreateProject calls CreateProjectFunc.
+func (mock *Client) CreateProject(ops *teamwork.CreateProjectOps) (*teamwork.CreateProjectResponse, error) {
+	mock.calls.record("CreateProject", ops)
+	if mock.CreateProjectFunc == nil {
+		panic("teamworkmock: Client.CreateProjectFunc is not set")
+	}
+	return mock.CreateProjectFunc(ops)
+}
+
+// CreateProjectContext calls CreateProjectContextFunc.
+func (mock *Client) CreateProjectContext(ctx context.Context, ops *teamwork.CreateProjectOps) (*teamwork.CreateProjectResponse, error) {
+	mock.calls.record("CreateProjectContext", ctx, ops)
+	if mock.CreateProjectContextFunc == nil {
+		panic("teamworkmock: Client.CreateProjectContextFunc is not set")
+	}
+	return mock.CreateProjectContextFunc(ctx, ops)
+}
+
+// UpdateProject calls UpdateProjectFunc.
+func (mock *Client) UpdateProject(id string, ops *teamwork.UpdateProjectOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateProject", id, ops)
+	if mock.UpdateProjectFunc == nil {
+		panic("teamworkmock: Client.UpdateProjectFunc is not set")
+	}
+	return mock.UpdateProjectFunc(id, ops)
+}
+
+// UpdateProjectContext calls UpdateProjectContextFunc.
+func (mock *Client) UpdateProjectContext(ctx context.Context, id string, ops *teamwork.UpdateProjectOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateProjectContext", ctx, id, ops)
+	if mock.UpdateProjectContextFunc == nil {
+		panic("teamworkmock: Client.UpdateProjectContextFunc is not set")
+	}
+	return mock.UpdateProjectContextFunc(ctx, id, ops)
+}
+
+// DeleteProject calls DeleteProjectFunc.
+func (mock *Client) DeleteProject(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteProject", id)
+	if mock.DeleteProjectFunc == nil {
+		panic("teamworkmock: Client.DeleteProjectFunc is not set")
+	}
+	return mock.DeleteProjectFunc(id)
+}
+
+// DeleteProjectContext calls DeleteProjectContextFunc.
+func (mock *Client) DeleteProjectContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteProjectContext", ctx, id)
+	if mock.DeleteProjectContextFunc == nil {
+		panic("teamworkmock: Client.DeleteProjectContextFunc is not set")
+	}
+	return mock.DeleteProjectContextFunc(ctx, id)
+}
+
+// ArchiveProject calls ArchiveProjectFunc.
+func (mock *Client) ArchiveProject(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("ArchiveProject", id)
+	if mock.ArchiveProjectFunc == nil {
+		panic("teamworkmock: Client.ArchiveProjectFunc is not set")
+	}
+	return mock.ArchiveProjectFunc(id)
+}
+
+// ArchiveProjectContext calls ArchiveProjectContextFunc.
+func (mock *Client) ArchiveProjectContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("ArchiveProjectContext", ctx, id)
+	if mock.ArchiveProjectContextFunc == nil {
+		panic("teamworkmock: Client.ArchiveProjectContextFunc is not set")
+	}
+	return mock.ArchiveProjectContextFunc(ctx, id)
+}
+
+// ReactivateProject calls ReactivateProjectFunc.
+func (mock *Client) ReactivateProject(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("ReactivateProject", id)
+	if mock.ReactivateProjectFunc == nil {
+		panic("teamworkmock: Client.ReactivateProjectFunc is not set")
+	}
+	return mock.ReactivateProjectFunc(id)
+}
+
+// ReactivateProjectContext calls ReactivateProjectContextFunc.
+func (mock *Client) ReactivateProjectContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("ReactivateProjectContext", ctx, id)
+	if mock.ReactivateProjectContextFunc == nil {
+		panic("teamworkmock: Client.ReactivateProjectContextFunc is not set")
+	}
+	return mock.ReactivateProjectContextFunc(ctx, id)
+}
+
+// StarProject calls StarProjectFunc.
+func (mock *Client) StarProject(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("StarProject", id)
+	if mock.StarProjectFunc == nil {
+		panic("teamworkmock: Client.StarProjectFunc is not set")
+	}
+	return mock.StarProjectFunc(id)
+}
+
+// StarProjectContext calls StarProjectContextFunc.
+func (mock *Client) StarProjectContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("StarProjectContext", ctx, id)
+	if mock.StarProjectContextFunc == nil {
+		panic("teamworkmock: Client.StarProjectContextFunc is not set")
+	}
+	return mock.StarProjectContextFunc(ctx, id)
+}
+
+// UnstarProject calls UnstarProjectFunc.
+func (mock *Client) UnstarProject(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UnstarProject", id)
+	if mock.UnstarProjectFunc == nil {
+		panic("teamworkmock: Client.UnstarProjectFunc is not set")
+	}
+	return mock.UnstarProjectFunc(id)
+}
+
+// UnstarProjectContext calls UnstarProjectContextFunc.
+func (mock *Client) UnstarProjectContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UnstarProjectContext", ctx, id)
+	if mock.UnstarProjectContextFunc == nil {
+		panic("teamworkmock: Client.UnstarProjectContextFunc is not set")
+	}
+	return mock.UnstarProjectContextFunc(ctx, id)
+}
+
+// AddPeopleToProject calls AddPeopleToProjectFunc.
+func (mock *Client) AddPeopleToProject(projectID string, personIDs []string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("AddPeopleToProject", projectID, personIDs)
+	if mock.AddPeopleToProjectFunc == nil {
+		panic("teamworkmock: Client.AddPeopleToProjectFunc is not set")
+	}
+	return mock.AddPeopleToProjectFunc(projectID, personIDs)
+}
+
+// AddPeopleToProjectContext calls AddPeopleToProjectContextFunc.
+func (mock *Client) AddPeopleToProjectContext(ctx context.Context, projectID string, personIDs []string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("AddPeopleToProjectContext", ctx, projectID, personIDs)
+	if mock.AddPeopleToProjectContextFunc == nil {
+		panic("teamworkmock: Client.AddPeopleToProjectContextFunc is not set")
+	}
+	return mock.AddPeopleToProjectContextFunc(ctx, projectID, personIDs)
+}
+
+// RemovePersonFromProject calls RemovePersonFromProjectFunc.
+func (mock *Client) RemovePersonFromProject(projectID string, personID string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("RemovePersonFromProject", projectID, personID)
+	if mock.RemovePersonFromProjectFunc == nil {
+		panic("teamworkmock: Client.RemovePersonFromProjectFunc is not set")
+	}
+	return mock.RemovePersonFromProjectFunc(projectID, personID)
+}
+
+// RemovePersonFromProjectContext calls RemovePersonFromProjectContextFunc.
+func (mock *Client) RemovePersonFromProjectContext(ctx context.Context, projectID string, personID string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("RemovePersonFromProjectContext", ctx, projectID, personID)
+	if mock.RemovePersonFromProjectContextFunc == nil {
+		panic("teamworkmock: Client.RemovePersonFromProjectContextFunc is not set")
+	}
+	return mock.RemovePersonFromProjectContextFunc(ctx, projectID, personID)
+}
+
+// SetProjectPeople calls SetProjectPeopleFunc.
+func (mock *Client) SetProjectPeople(projectID string, personIDs []string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("SetProjectPeople", projectID, personIDs)
+	if mock.SetProjectPeopleFunc == nil {
+		panic("teamworkmock: Client.SetProjectPeopleFunc is not set")
+	}
+	return mock.SetProjectPeopleFunc(projectID, personIDs)
+}
+
+// SetProjectPeopleContext calls SetProjectPeopleContextFunc.
+func (mock *Client) SetProjectPeopleContext(ctx context.Context, projectID string, personIDs []string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("SetProjectPeopleContext", ctx, projectID, personIDs)
+	if mock.SetProjectPeopleContextFunc == nil {
+		panic("teamworkmock: Client.SetProjectPeopleContextFunc is not set")
+	}
+	return mock.SetProjectPeopleContextFunc(ctx, projectID, personIDs)
+}
+
+// UpdateProjectPermissions calls UpdateProjectPermissionsFunc.
+func (mock *Client) UpdateProjectPermissions(projectID string, personID string, perms *teamwork.ProjectPermissions) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateProjectPermissions", projectID, personID, perms)
+	if mock.UpdateProjectPermissionsFunc == nil {
+		panic("teamworkmock: Client.UpdateProjectPermissionsFunc is not set")
+	}
+	return mock.UpdateProjectPermissionsFunc(projectID, personID, perms)
+}
+
+// UpdateProjectPermissionsContext calls UpdateProjectPermissionsContextFunc.
+func (mock *Client) UpdateProjectPermissionsContext(ctx context.Context, projectID string, personID string, perms *teamwork.ProjectPermissions) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateProjectPermissionsContext", ctx, projectID, personID, perms)
+	if mock.UpdateProjectPermissionsContextFunc == nil {
+		panic("teamworkmock: Client.UpdateProjectPermissionsContextFunc is not set")
+	}
+	return mock.UpdateProjectPermissionsContextFunc(ctx, projectID, personID, perms)
+}
+
+// GetPeople calls GetPeopleFunc.
+func (mock *Client) GetPeople(ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error) {
+	mock.calls.record("GetPeople", ops)
+	if mock.GetPeopleFunc == nil {
+		panic("teamworkmock: Client.GetPeopleFunc is not set")
+	}
+	return mock.GetPeopleFunc(ops)
+}
+
+// GetPeopleContext calls GetPeopleContextFunc.
+func (mock *Client) GetPeopleContext(ctx context.Context, ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error) {
+	mock.calls.record("GetPeopleContext", ctx, ops)
+	if mock.GetPeopleContextFunc == nil {
+		panic("teamworkmock: Client.GetPeopleContextFunc is not set")
+	}
+	return mock.GetPeopleContextFunc(ctx, ops)
+}
+
+// AllPeople calls AllPeopleFunc.
+func (mock *Client) AllPeople(ctx context.Context, ops *teamwork.GetPeopleOps) iter.Seq2[teamwork.Person, error] {
+	mock.calls.record("AllPeople", ctx, ops)
+	if mock.AllPeopleFunc == nil {
+		panic("teamworkmock: Client.AllPeopleFunc is not set")
+	}
+	return mock.AllPeopleFunc(ctx, ops)
+}
+
+// GetAllPeople calls GetAllPeopleFunc.
+func (mock *Client) GetAllPeople(ops *teamwork.GetPeopleOps) (teamwork.People, error) {
+	mock.calls.record("GetAllPeople", ops)
+	if mock.GetAllPeopleFunc == nil {
+		panic("teamworkmock: Client.GetAllPeopleFunc is not set")
+	}
+	return mock.GetAllPeopleFunc(ops)
+}
+
+// GetAllPeopleContext calls GetAllPeopleContextFunc.
+func (mock *Client) GetAllPeopleContext(ctx context.Context, ops *teamwork.GetPeopleOps) (teamwork.People, error) {
+	mock.calls.record("GetAllPeopleContext", ctx, ops)
+	if mock.GetAllPeopleContextFunc == nil {
+		panic("teamworkmock: Client.GetAllPeopleContextFunc is not set")
+	}
+	return mock.GetAllPeopleContextFunc(ctx, ops)
+}
+
+// GetProjectPeople calls GetProjectPeopleFunc.
+func (mock *Client) GetProjectPeople(id string, ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error) {
+	mock.calls.record("GetProjectPeople", id, ops)
+	if mock.GetProjectPeopleFunc == nil {
+		panic("teamworkmock: Client.GetProjectPeopleFunc is not set")
+	}
+	return mock.GetProjectPeopleFunc(id, ops)
+}
+
+// GetProjectPeopleContext calls GetProjectPeopleContextFunc.
+func (mock *Client) GetProjectPeopleContext(ctx context.Context, id string, ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error) {
+	mock.calls.record("GetProjectPeopleContext", ctx, id, ops)
+	if mock.GetProjectPeopleContextFunc == nil {
+		panic("teamworkmock: Client.GetProjectPeopleContextFunc is not set")
+	}
+	return mock.GetProjectPeopleContextFunc(ctx, id, ops)
+}
+
+// AllProjectPeople calls AllProjectPeopleFunc.
+func (mock *Client) AllProjectPeople(ctx context.Context, id string, ops *teamwork.GetPeopleOps) iter.Seq2[teamwork.Person, error] {
+	mock.calls.record("AllProjectPeople", ctx, id, ops)
+	if mock.AllProjectPeopleFunc == nil {
+		panic("teamworkmock: Client.AllProjectPeopleFunc is not set")
+	}
+	return mock.AllProjectPeopleFunc(ctx, id, ops)
+}
+
+// GetAllProjectPeople calls GetAllProjectPeopleFunc.
+func (mock *Client) GetAllProjectPeople(id string, ops *teamwork.GetPeopleOps) (teamwork.People, error) {
+	mock.calls.record("GetAllProjectPeople", id, ops)
+	if mock.GetAllProjectPeopleFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectPeopleFunc is not set")
+	}
+	return mock.GetAllProjectPeopleFunc(id, ops)
+}
+
+// GetAllProjectPeopleContext calls GetAllProjectPeopleContextFunc.
+func (mock *Client) GetAllProjectPeopleContext(ctx context.Context, id string, ops *teamwork.GetPeopleOps) (teamwork.People, error) {
+	mock.calls.record("GetAllProjectPeopleContext", ctx, id, ops)
+	if mock.GetAllProjectPeopleContextFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectPeopleContextFunc is not set")
+	}
+	return mock.GetAllProjectPeopleContextFunc(ctx, id, ops)
+}
+
+// GetCompanyPeople calls GetCompanyPeopleFunc.
+func (mock *Client) GetCompanyPeople(id string, ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error) {
+	mock.calls.record("GetCompanyPeople", id, ops)
+	if mock.GetCompanyPeopleFunc == nil {
+		panic("teamworkmock: Client.GetCompanyPeopleFunc is not set")
+	}
+	return mock.GetCompanyPeopleFunc(id, ops)
+}
+
+// GetCompanyPeopleContext calls GetCompanyPeopleContextFunc.
+func (mock *Client) GetCompanyPeopleContext(ctx context.Context, id string, ops *teamwork.GetPeopleOps) (teamwork.People, teamwork.Pages, error) {
+	mock.calls.record("GetCompanyPeopleContext", ctx, id, ops)
+	if mock.GetCompanyPeopleContextFunc == nil {
+		panic("teamworkmock: Client.GetCompanyPeopleContextFunc is not set")
+	}
+	return mock.GetCompanyPeopleContextFunc(ctx, id, ops)
+}
+
+// AllCompanyPeople calls AllCompanyPeopleFunc.
+func (mock *Client) AllCompanyPeople(ctx context.Context, id string, ops *teamwork.GetPeopleOps) iter.Seq2[teamwork.Person, error] {
+	mock.calls.record("AllCompanyPeople", ctx, id, ops)
+	if mock.AllCompanyPeopleFunc == nil {
+		panic("teamworkmock: Client.AllCompanyPeopleFunc is not set")
+	}
+	return mock.AllCompanyPeopleFunc(ctx, id, ops)
+}
+
+// GetAllCompanyPeople calls GetAllCompanyPeopleFunc.
+func (mock *Client) GetAllCompanyPeople(id string, ops *teamwork.GetPeopleOps) (teamwork.People, error) {
+	mock.calls.record("GetAllCompanyPeople", id, ops)
+	if mock.GetAllCompanyPeopleFunc == nil {
+		panic("teamworkmock: Client.GetAllCompanyPeopleFunc is not set")
+	}
+	return mock.GetAllCompanyPeopleFunc(id, ops)
+}
+
+// GetAllCompanyPeopleContext calls GetAllCompanyPeopleContextFunc.
+func (mock *Client) GetAllCompanyPeopleContext(ctx context.Context, id string, ops *teamwork.GetPeopleOps) (teamwork.People, error) {
+	mock.calls.record("GetAllCompanyPeopleContext", ctx, id, ops)
+	if mock.GetAllCompanyPeopleContextFunc == nil {
+		panic("teamworkmock: Client.GetAllCompanyPeopleContextFunc is not set")
+	}
+	return mock.GetAllCompanyPeopleContextFunc(ctx, id, ops)
+}
+
+// GetPerson calls GetPersonFunc.
+func (mock *Client) GetPerson(id string) (teamwork.Person, error) {
+	mock.calls.record("GetPerson", id)
+	if mock.GetPersonFunc == nil {
+		panic("teamworkmock: Client.GetPersonFunc is not set")
+	}
+	return mock.GetPersonFunc(id)
+}
+
+// GetPersonContext calls GetPersonContextFunc.
+func (mock *Client) GetPersonContext(ctx context.Context, id string) (teamwork.Person, error) {
+	mock.calls.record("GetPersonContext", ctx, id)
+	if mock.GetPersonContextFunc == nil {
+		panic("teamworkmock: Client.GetPersonContextFunc is not set")
+	}
+	return mock.GetPersonContextFunc(ctx, id)
+}
+
+// GetCurrentPerson calls GetCurrentPersonFunc.
+func (mock *Client) GetCurrentPerson() (teamwork.Person, error) {
+	mock.calls.record("GetCurrentPerson")
+	if mock.GetCurrentPersonFunc == nil {
+		panic("teamworkmock: Client.GetCurrentPersonFunc is not set")
+	}
+	return mock.GetCurrentPersonFunc()
+}
+
+// GetCurrentPersonContext calls GetCurrentPersonContextFunc.
+func (mock *Client) GetCurrentPersonContext(ctx context.Context) (teamwork.Person, error) {
+	mock.calls.record("GetCurrentPersonContext", ctx)
+	if mock.GetCurrentPersonContextFunc == nil {
+		panic("teamworkmock: Client.GetCurrentPersonContextFunc is not set")
+	}
+	return mock.GetCurrentPersonContextFunc(ctx)
+}
+
+// CreatePerson calls CreatePersonFunc.
+func (mock *Client) CreatePerson(ops *teamwork.CreatePersonOps) (*teamwork.CreatePersonResponse, error) {
+	mock.calls.record("CreatePerson", ops)
+	if mock.CreatePersonFunc == nil {
+		panic("teamworkmock: Client.CreatePersonFunc is not set")
+	}
+	return mock.CreatePersonFunc(ops)
+}
+
+// CreatePersonContext calls CreatePersonContextFunc.
+func (mock *Client) CreatePersonContext(ctx context.Context, ops *teamwork.CreatePersonOps) (*teamwork.CreatePersonResponse, error) {
+	mock.calls.record("CreatePersonContext", ctx, ops)
+	if mock.CreatePersonContextFunc == nil {
+		panic("teamworkmock: Client.CreatePersonContextFunc is not set")
+	}
+	return mock.CreatePersonContextFunc(ctx, ops)
+}
+
+// InvitePerson calls InvitePersonFunc.
+func (mock *Client) InvitePerson(ops *teamwork.CreatePersonOps) (*teamwork.CreatePersonResponse, error) {
+	mock.calls.record("InvitePerson", ops)
+	if mock.InvitePersonFunc == nil {
+		panic("teamworkmock: Client.InvitePersonFunc is not set")
+	}
+	return mock.InvitePersonFunc(ops)
+}
+
+// InvitePersonContext calls InvitePersonContextFunc.
+func (mock *Client) InvitePersonContext(ctx context.Context, ops *teamwork.CreatePersonOps) (*teamwork.CreatePersonResponse, error) {
+	mock.calls.record("InvitePersonContext", ctx, ops)
+	if mock.InvitePersonContextFunc == nil {
+		panic("teamworkmock: Client.InvitePersonContextFunc is not set")
+	}
+	return mock.InvitePersonContextFunc(ctx, ops)
+}
+
+// UpdatePerson calls UpdatePersonFunc.
+func (mock *Client) UpdatePerson(id string, ops *teamwork.UpdatePersonOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdatePerson", id, ops)
+	if mock.UpdatePersonFunc == nil {
+		panic("teamworkmock: Client.UpdatePersonFunc is not set")
+	}
+	return mock.UpdatePersonFunc(id, ops)
+}
+
+// UpdatePersonContext calls UpdatePersonContextFunc.
+func (mock *Client) UpdatePersonContext(ctx context.Context, id string, ops *teamwork.UpdatePersonOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdatePersonContext", ctx, id, ops)
+	if mock.UpdatePersonContextFunc == nil {
+		panic("teamworkmock: Client.UpdatePersonContextFunc is not set")
+	}
+	return mock.UpdatePersonContextFunc(ctx, id, ops)
+}
+
+// SetPersonAdministrator calls SetPersonAdministratorFunc.
+func (mock *Client) SetPersonAdministrator(id string, administrator bool) (*teamwork.StatusResponse, error) {
+	mock.calls.record("SetPersonAdministrator", id, administrator)
+	if mock.SetPersonAdministratorFunc == nil {
+		panic("teamworkmock: Client.SetPersonAdministratorFunc is not set")
+	}
+	return mock.SetPersonAdministratorFunc(id, administrator)
+}
+
+// SetPersonAdministratorContext calls SetPersonAdministratorContextFunc.
+func (mock *Client) SetPersonAdministratorContext(ctx context.Context, id string, administrator bool) (*teamwork.StatusResponse, error) {
+	mock.calls.record("SetPersonAdministratorContext", ctx, id, administrator)
+	if mock.SetPersonAdministratorContextFunc == nil {
+		panic("teamworkmock: Client.SetPersonAdministratorContextFunc is not set")
+	}
+	return mock.SetPersonAdministratorContextFunc(ctx, id, administrator)
+}
+
+// DeletePerson calls DeletePersonFunc.
+func (mock *Client) DeletePerson(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeletePerson", id)
+	if mock.DeletePersonFunc == nil {
+		panic("teamworkmock: Client.DeletePersonFunc is not set")
+	}
+	return mock.DeletePersonFunc(id)
+}
+
+// DeletePersonContext calls DeletePersonContextFunc.
+func (mock *Client) DeletePersonContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeletePersonContext", ctx, id)
+	if mock.DeletePersonContextFunc == nil {
+		panic("teamworkmock: Client.DeletePersonContextFunc is not set")
+	}
+	return mock.DeletePersonContextFunc(ctx, id)
+}
+
+// GetCompanies calls GetCompaniesFunc.
+func (mock *Client) GetCompanies(ops *teamwork.GetCompaniesOps) (teamwork.Companies, teamwork.Pages, error) {
+	mock.calls.record("GetCompanies", ops)
+	if mock.GetCompaniesFunc == nil {
+		panic("teamworkmock: Client.GetCompaniesFunc is not set")
+	}
+	return mock.GetCompaniesFunc(ops)
+}
+
+// GetCompaniesContext calls GetCompaniesContextFunc.
+func (mock *Client) GetCompaniesContext(ctx context.Context, ops *teamwork.GetCompaniesOps) (teamwork.Companies, teamwork.Pages, error) {
+	mock.calls.record("GetCompaniesContext", ctx, ops)
+	if mock.GetCompaniesContextFunc == nil {
+		panic("teamworkmock: Client.GetCompaniesContextFunc is not set")
+	}
+	return mock.GetCompaniesContextFunc(ctx, ops)
+}
+
+// AllCompanies calls AllCompaniesFunc.
+func (mock *Client) AllCompanies(ctx context.Context, ops *teamwork.GetCompaniesOps) iter.Seq2[teamwork.Company, error] {
+	mock.calls.record("AllCompanies", ctx, ops)
+	if mock.AllCompaniesFunc == nil {
+		panic("teamworkmock: Client.AllCompaniesFunc is not set")
+	}
+	return mock.AllCompaniesFunc(ctx, ops)
+}
+
+// GetAllCompanies calls GetAllCompaniesFunc.
+func (mock *Client) GetAllCompanies(ops *teamwork.GetCompaniesOps) (teamwork.Companies, error) {
+	mock.calls.record("GetAllCompanies", ops)
+	if mock.GetAllCompaniesFunc == nil {
+		panic("teamworkmock: Client.GetAllCompaniesFunc is not set")
+	}
+	return mock.GetAllCompaniesFunc(ops)
+}
+
+// GetAllCompaniesContext calls GetAllCompaniesContextFunc.
+func (mock *Client) GetAllCompaniesContext(ctx context.Context, ops *teamwork.GetCompaniesOps) (teamwork.Companies, error) {
+	mock.calls.record("GetAllCompaniesContext", ctx, ops)
+	if mock.GetAllCompaniesContextFunc == nil {
+		panic("teamworkmock: Client.GetAllCompaniesContextFunc is not set")
+	}
+	return mock.GetAllCompaniesContextFunc(ctx, ops)
+}
+
+// GetCompany calls GetCompanyFunc.
+func (mock *Client) GetCompany(id string) (teamwork.Company, error) {
+	mock.calls.record("GetCompany", id)
+	if mock.GetCompanyFunc == nil {
+		panic("teamworkmock: Client.GetCompanyFunc is not set")
+	}
+	return mock.GetCompanyFunc(id)
+}
+
+// GetCompanyContext calls GetCompanyContextFunc.
+func (mock *Client) GetCompanyContext(ctx context.Context, id string) (teamwork.Company, error) {
+	mock.calls.record("GetCompanyContext", ctx, id)
+	if mock.GetCompanyContextFunc == nil {
+		panic("teamworkmock: Client.GetCompanyContextFunc is not set")
+	}
+	return mock.GetCompanyContextFunc(ctx, id)
+}
+
+// GetCompanyProjects calls GetCompanyProjectsFunc.
+func (mock *Client) GetCompanyProjects(id string, ops *teamwork.GetProjectsOps) (teamwork.Projects, teamwork.Pages, error) {
+	mock.calls.record("GetCompanyProjects", id, ops)
+	if mock.GetCompanyProjectsFunc == nil {
+		panic("teamworkmock: Client.GetCompanyProjectsFunc is not set")
+	}
+	return mock.GetCompanyProjectsFunc(id, ops)
+}
+
+// GetCompanyProjectsContext calls GetCompanyProjectsContextFunc.
+func (mock *Client) GetCompanyProjectsContext(ctx context.Context, id string, ops *teamwork.GetProjectsOps) (teamwork.Projects, teamwork.Pages, error) {
+	mock.calls.record("GetCompanyProjectsContext", ctx, id, ops)
+	if mock.GetCompanyProjectsContextFunc == nil {
+		panic("teamworkmock: Client.GetCompanyProjectsContextFunc is not set")
+	}
+	return mock.GetCompanyProjectsContextFunc(ctx, id, ops)
+}
+
+// AllCompanyProjects calls AllCompanyProjectsFunc.
+func (mock *Client) AllCompanyProjects(ctx context.Context, id string, ops *teamwork.GetProjectsOps) iter.Seq2[teamwork.Project, error] {
+	mock.calls.record("AllCompanyProjects", ctx, id, ops)
+	if mock.AllCompanyProjectsFunc == nil {
+		panic("teamworkmock: Client.AllCompanyProjectsFunc is not set")
+	}
+	return mock.AllCompanyProjectsFunc(ctx, id, ops)
+}
+
+// GetAllCompanyProjects calls GetAllCompanyProjectsFunc.
+func (mock *Client) GetAllCompanyProjects(id string, ops *teamwork.GetProjectsOps) (teamwork.Projects, error) {
+	mock.calls.record("GetAllCompanyProjects", id, ops)
+	if mock.GetAllCompanyProjectsFunc == nil {
+		panic("teamworkmock: Client.GetAllCompanyProjectsFunc is not set")
+	}
+	return mock.GetAllCompanyProjectsFunc(id, ops)
+}
+
+// GetAllCompanyProjectsContext calls GetAllCompanyProjectsContextFunc.
+func (mock *Client) GetAllCompanyProjectsContext(ctx context.Context, id string, ops *teamwork.GetProjectsOps) (teamwork.Projects, error) {
+	mock.calls.record("GetAllCompanyProjectsContext", ctx, id, ops)
+	if mock.GetAllCompanyProjectsContextFunc == nil {
+		panic("teamworkmock: Client.GetAllCompanyProjectsContextFunc is not set")
+	}
+	return mock.GetAllCompanyProjectsContextFunc(ctx, id, ops)
+}
+
+// CreateCompany calls CreateCompanyFunc.
+func (mock *Client) CreateCompany(ops *teamwork.CreateCompanyOps) (*teamwork.CreateCompanyResponse, error) {
+	mock.calls.record("CreateCompany", ops)
+	if mock.CreateCompanyFunc == nil {
+		panic("teamworkmock: Client.CreateCompanyFunc is not set")
+	}
+	return mock.CreateCompanyFunc(ops)
+}
+
+// CreateCompanyContext calls CreateCompanyContextFunc.
+func (mock *Client) CreateCompanyContext(ctx context.Context, ops *teamwork.CreateCompanyOps) (*teamwork.CreateCompanyResponse, error) {
+	mock.calls.record("CreateCompanyContext", ctx, ops)
+	if mock.CreateCompanyContextFunc == nil {
+		panic("teamworkmock: Client.CreateCompanyContextFunc is not set")
+	}
+	return mock.CreateCompanyContextFunc(ctx, ops)
+}
+
+// UpdateCompany calls UpdateCompanyFunc.
+func (mock *Client) UpdateCompany(id string, ops *teamwork.UpdateCompanyOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateCompany", id, ops)
+	if mock.UpdateCompanyFunc == nil {
+		panic("teamworkmock: Client.UpdateCompanyFunc is not set")
+	}
+	return mock.UpdateCompanyFunc(id, ops)
+}
+
+// UpdateCompanyContext calls UpdateCompanyContextFunc.
+func (mock *Client) UpdateCompanyContext(ctx context.Context, id string, ops *teamwork.UpdateCompanyOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateCompanyContext", ctx, id, ops)
+	if mock.UpdateCompanyContextFunc == nil {
+		panic("teamworkmock: Client.UpdateCompanyContextFunc is not set")
+	}
+	return mock.UpdateCompanyContextFunc(ctx, id, ops)
+}
+
+// DeleteCompany calls DeleteCompanyFunc.
+func (mock *Client) DeleteCompany(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteCompany", id)
+	if mock.DeleteCompanyFunc == nil {
+		panic("teamworkmock: Client.DeleteCompanyFunc is not set")
+	}
+	return mock.DeleteCompanyFunc(id)
+}
+
+// DeleteCompanyContext calls DeleteCompanyContextFunc.
+func (mock *Client) DeleteCompanyContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteCompanyContext", ctx, id)
+	if mock.DeleteCompanyContextFunc == nil {
+		panic("teamworkmock: Client.DeleteCompanyContextFunc is not set")
+	}
+	return mock.DeleteCompanyContextFunc(ctx, id)
+}
+
+// GetTasks calls GetTasksFunc.
+func (mock *Client) GetTasks(ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error) {
+	mock.calls.record("GetTasks", ops)
+	if mock.GetTasksFunc == nil {
+		panic("teamworkmock: Client.GetTasksFunc is not set")
+	}
+	return mock.GetTasksFunc(ops)
+}
+
+// GetTasksContext calls GetTasksContextFunc.
+func (mock *Client) GetTasksContext(ctx context.Context, ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error) {
+	mock.calls.record("GetTasksContext", ctx, ops)
+	if mock.GetTasksContextFunc == nil {
+		panic("teamworkmock: Client.GetTasksContextFunc is not set")
+	}
+	return mock.GetTasksContextFunc(ctx, ops)
+}
+
+// AllTasks calls AllTasksFunc.
+func (mock *Client) AllTasks(ctx context.Context, ops *teamwork.GetTasksOps) iter.Seq2[teamwork.Task, error] {
+	mock.calls.record("AllTasks", ctx, ops)
+	if mock.AllTasksFunc == nil {
+		panic("teamworkmock: Client.AllTasksFunc is not set")
+	}
+	return mock.AllTasksFunc(ctx, ops)
+}
+
+// GetAllTasks calls GetAllTasksFunc.
+func (mock *Client) GetAllTasks(ops *teamwork.GetTasksOps) (teamwork.Tasks, error) {
+	mock.calls.record("GetAllTasks", ops)
+	if mock.GetAllTasksFunc == nil {
+		panic("teamworkmock: Client.GetAllTasksFunc is not set")
+	}
+	return mock.GetAllTasksFunc(ops)
+}
+
+// GetAllTasksContext calls GetAllTasksContextFunc.
+func (mock *Client) GetAllTasksContext(ctx context.Context, ops *teamwork.GetTasksOps) (teamwork.Tasks, error) {
+	mock.calls.record("GetAllTasksContext", ctx, ops)
+	if mock.GetAllTasksContextFunc == nil {
+		panic("teamworkmock: Client.GetAllTasksContextFunc is not set")
+	}
+	return mock.GetAllTasksContextFunc(ctx, ops)
+}
+
+// GetProjectTasks calls GetProjectTasksFunc.
+func (mock *Client) GetProjectTasks(id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error) {
+	mock.calls.record("GetProjectTasks", id, ops)
+	if mock.GetProjectTasksFunc == nil {
+		panic("teamworkmock: Client.GetProjectTasksFunc is not set")
+	}
+	return mock.GetProjectTasksFunc(id, ops)
+}
+
+// GetProjectTasksContext calls GetProjectTasksContextFunc.
+func (mock *Client) GetProjectTasksContext(ctx context.Context, id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error) {
+	mock.calls.record("GetProjectTasksContext", ctx, id, ops)
+	if mock.GetProjectTasksContextFunc == nil {
+		panic("teamworkmock: Client.GetProjectTasksContextFunc is not set")
+	}
+	return mock.GetProjectTasksContextFunc(ctx, id, ops)
+}
+
+// AllProjectTasks calls AllProjectTasksFunc.
+func (mock *Client) AllProjectTasks(ctx context.Context, id string, ops *teamwork.GetTasksOps) iter.Seq2[teamwork.Task, error] {
+	mock.calls.record("AllProjectTasks", ctx, id, ops)
+	if mock.AllProjectTasksFunc == nil {
+		panic("teamworkmock: Client.AllProjectTasksFunc is not set")
+	}
+	return mock.AllProjectTasksFunc(ctx, id, ops)
+}
+
+// GetAllProjectTasks calls GetAllProjectTasksFunc.
+func (mock *Client) GetAllProjectTasks(id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, error) {
+	mock.calls.record("GetAllProjectTasks", id, ops)
+	if mock.GetAllProjectTasksFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectTasksFunc is not set")
+	}
+	return mock.GetAllProjectTasksFunc(id, ops)
+}
+
+// GetAllProjectTasksContext calls GetAllProjectTasksContextFunc.
+func (mock *Client) GetAllProjectTasksContext(ctx context.Context, id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, error) {
+	mock.calls.record("GetAllProjectTasksContext", ctx, id, ops)
+	if mock.GetAllProjectTasksContextFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectTasksContextFunc is not set")
+	}
+	return mock.GetAllProjectTasksContextFunc(ctx, id, ops)
+}
+
+// GetTaskListTasks calls GetTaskListTasksFunc.
+func (mock *Client) GetTaskListTasks(id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error) {
+	mock.calls.record("GetTaskListTasks", id, ops)
+	if mock.GetTaskListTasksFunc == nil {
+		panic("teamworkmock: Client.GetTaskListTasksFunc is not set")
+	}
+	return mock.GetTaskListTasksFunc(id, ops)
+}
+
+// GetTaskListTasksContext calls GetTaskListTasksContextFunc.
+func (mock *Client) GetTaskListTasksContext(ctx context.Context, id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, teamwork.Pages, error) {
+	mock.calls.record("GetTaskListTasksContext", ctx, id, ops)
+	if mock.GetTaskListTasksContextFunc == nil {
+		panic("teamworkmock: Client.GetTaskListTasksContextFunc is not set")
+	}
+	return mock.GetTaskListTasksContextFunc(ctx, id, ops)
+}
+
+// AllTaskListTasks calls AllTaskListTasksFunc.
+func (mock *Client) AllTaskListTasks(ctx context.Context, id string, ops *teamwork.GetTasksOps) iter.Seq2[teamwork.Task, error] {
+	mock.calls.record("AllTaskListTasks", ctx, id, ops)
+	if mock.AllTaskListTasksFunc == nil {
+		panic("teamworkmock: Client.AllTaskListTasksFunc is not set")
+	}
+	return mock.AllTaskListTasksFunc(ctx, id, ops)
+}
+
+// GetAllTaskListTasks calls GetAllTaskListTasksFunc.
+func (mock *Client) GetAllTaskListTasks(id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, error) {
+	mock.calls.record("GetAllTaskListTasks", id, ops)
+	if mock.GetAllTaskListTasksFunc == nil {
+		panic("teamworkmock: Client.GetAllTaskListTasksFunc is not set")
+	}
+	return mock.GetAllTaskListTasksFunc(id, ops)
+}
+
+// GetAllTaskListTasksContext calls GetAllTaskListTasksContextFunc.
+func (mock *Client) GetAllTaskListTasksContext(ctx context.Context, id string, ops *teamwork.GetTasksOps) (teamwork.Tasks, error) {
+	mock.calls.record("GetAllTaskListTasksContext", ctx, id, ops)
+	if mock.GetAllTaskListTasksContextFunc == nil {
+		panic("teamworkmock: Client.GetAllTaskListTasksContextFunc is not set")
+	}
+	return mock.GetAllTaskListTasksContextFunc(ctx, id, ops)
+}
+
+// CreateTask calls CreateTaskFunc.
+func (mock *Client) CreateTask(taskListID string, ops *teamwork.CreateTaskOps) (*teamwork.CreateTaskResponse, error) {
+	mock.calls.record("CreateTask", taskListID, ops)
+	if mock.CreateTaskFunc == nil {
+		panic("teamworkmock: Client.CreateTaskFunc is not set")
+	}
+	return mock.CreateTaskFunc(taskListID, ops)
+}
+
+// CreateTaskContext calls CreateTaskContextFunc.
+func (mock *Client) CreateTaskContext(ctx context.Context, taskListID string, ops *teamwork.CreateTaskOps) (*teamwork.CreateTaskResponse, error) {
+	mock.calls.record("CreateTaskContext", ctx, taskListID, ops)
+	if mock.CreateTaskContextFunc == nil {
+		panic("teamworkmock: Client.CreateTaskContextFunc is not set")
+	}
+	return mock.CreateTaskContextFunc(ctx, taskListID, ops)
+}
+
+// CreateSubTask calls CreateSubTaskFunc.
+func (mock *Client) CreateSubTask(taskID string, ops *teamwork.CreateTaskOps) (*teamwork.CreateTaskResponse, error) {
+	mock.calls.record("CreateSubTask", taskID, ops)
+	if mock.CreateSubTaskFunc == nil {
+		panic("teamworkmock: Client.CreateSubTaskFunc is not set")
+	}
+	return mock.CreateSubTaskFunc(taskID, ops)
+}
+
+// CreateSubTaskContext calls CreateSubTaskContextFunc.
+func (mock *Client) CreateSubTaskContext(ctx context.Context, taskID string, ops *teamwork.CreateTaskOps) (*teamwork.CreateTaskResponse, error) {
+	mock.calls.record("CreateSubTaskContext", ctx, taskID, ops)
+	if mock.CreateSubTaskContextFunc == nil {
+		panic("teamworkmock: Client.CreateSubTaskContextFunc is not set")
+	}
+	return mock.CreateSubTaskContextFunc(ctx, taskID, ops)
+}
+
+// UpdateTask calls UpdateTaskFunc.
+func (mock *Client) UpdateTask(id string, ops *teamwork.UpdateTaskOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateTask", id, ops)
+	if mock.UpdateTaskFunc == nil {
+		panic("teamworkmock: Client.UpdateTaskFunc is not set")
+	}
+	return mock.UpdateTaskFunc(id, ops)
+}
+
+// UpdateTaskContext calls UpdateTaskContextFunc.
+func (mock *Client) UpdateTaskContext(ctx context.Context, id string, ops *teamwork.UpdateTaskOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateTaskContext", ctx, id, ops)
+	if mock.UpdateTaskContextFunc == nil {
+		panic("teamworkmock: Client.UpdateTaskContextFunc is not set")
+	}
+	return mock.UpdateTaskContextFunc(ctx, id, ops)
+}
+
+// MoveTask calls MoveTaskFunc.
+func (mock *Client) MoveTask(id string, taskListID string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("MoveTask", id, taskListID)
+	if mock.MoveTaskFunc == nil {
+		panic("teamworkmock: Client.MoveTaskFunc is not set")
+	}
+	return mock.MoveTaskFunc(id, taskListID)
+}
+
+// MoveTaskContext calls MoveTaskContextFunc.
+func (mock *Client) MoveTaskContext(ctx context.Context, id string, taskListID string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("MoveTaskContext", ctx, id, taskListID)
+	if mock.MoveTaskContextFunc == nil {
+		panic("teamworkmock: Client.MoveTaskContextFunc is not set")
+	}
+	return mock.MoveTaskContextFunc(ctx, id, taskListID)
+}
+
+// CompleteTask calls CompleteTaskFunc.
+func (mock *Client) CompleteTask(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("CompleteTask", id)
+	if mock.CompleteTaskFunc == nil {
+		panic("teamworkmock: Client.CompleteTaskFunc is not set")
+	}
+	return mock.CompleteTaskFunc(id)
+}
+
+// CompleteTaskContext calls CompleteTaskContextFunc.
+func (mock *Client) CompleteTaskContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("CompleteTaskContext", ctx, id)
+	if mock.CompleteTaskContextFunc == nil {
+		panic("teamworkmock: Client.CompleteTaskContextFunc is not set")
+	}
+	return mock.CompleteTaskContextFunc(ctx, id)
+}
+
+// UncompleteTask calls UncompleteTaskFunc.
+func (mock *Client) UncompleteTask(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UncompleteTask", id)
+	if mock.UncompleteTaskFunc == nil {
+		panic("teamworkmock: Client.UncompleteTaskFunc is not set")
+	}
+	return mock.UncompleteTaskFunc(id)
+}
+
+// UncompleteTaskContext calls UncompleteTaskContextFunc.
+func (mock *Client) UncompleteTaskContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UncompleteTaskContext", ctx, id)
+	if mock.UncompleteTaskContextFunc == nil {
+		panic("teamworkmock: Client.UncompleteTaskContextFunc is not set")
+	}
+	return mock.UncompleteTaskContextFunc(ctx, id)
+}
+
+// DeleteTask calls DeleteTaskFunc.
+func (mock *Client) DeleteTask(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteTask", id)
+	if mock.DeleteTaskFunc == nil {
+		panic("teamworkmock: Client.DeleteTaskFunc is not set")
+	}
+	return mock.DeleteTaskFunc(id)
+}
+
+// DeleteTaskContext calls DeleteTaskContextFunc.
+func (mock *Client) DeleteTaskContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteTaskContext", ctx, id)
+	if mock.DeleteTaskContextFunc == nil {
+		panic("teamworkmock: Client.DeleteTaskContextFunc is not set")
+	}
+	return mock.DeleteTaskContextFunc(ctx, id)
+}
+
+// GetProjectTaskLists calls GetProjectTaskListsFunc.
+func (mock *Client) GetProjectTaskLists(id string, ops *teamwork.GetProjectTaskListsOps) (teamwork.TaskLists, teamwork.Pages, error) {
+	mock.calls.record("GetProjectTaskLists", id, ops)
+	if mock.GetProjectTaskListsFunc == nil {
+		panic("teamworkmock: Client.GetProjectTaskListsFunc is not set")
+	}
+	return mock.GetProjectTaskListsFunc(id, ops)
+}
+
+// GetProjectTaskListsContext calls GetProjectTaskListsContextFunc.
+func (mock *Client) GetProjectTaskListsContext(ctx context.Context, id string, ops *teamwork.GetProjectTaskListsOps) (teamwork.TaskLists, teamwork.Pages, error) {
+	mock.calls.record("GetProjectTaskListsContext", ctx, id, ops)
+	if mock.GetProjectTaskListsContextFunc == nil {
+		panic("teamworkmock: Client.GetProjectTaskListsContextFunc is not set")
+	}
+	return mock.GetProjectTaskListsContextFunc(ctx, id, ops)
+}
+
+// AllProjectTaskLists calls AllProjectTaskListsFunc.
+func (mock *Client) AllProjectTaskLists(ctx context.Context, id string, ops *teamwork.GetProjectTaskListsOps) iter.Seq2[teamwork.TaskList, error] {
+	mock.calls.record("AllProjectTaskLists", ctx, id, ops)
+	if mock.AllProjectTaskListsFunc == nil {
+		panic("teamworkmock: Client.AllProjectTaskListsFunc is not set")
+	}
+	return mock.AllProjectTaskListsFunc(ctx, id, ops)
+}
+
+// GetAllProjectTaskLists calls GetAllProjectTaskListsFunc.
+func (mock *Client) GetAllProjectTaskLists(id string, ops *teamwork.GetProjectTaskListsOps) (teamwork.TaskLists, error) {
+	mock.calls.record("GetAllProjectTaskLists", id, ops)
+	if mock.GetAllProjectTaskListsFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectTaskListsFunc is not set")
+	}
+	return mock.GetAllProjectTaskListsFunc(id, ops)
+}
+
+// GetAllProjectTaskListsContext calls GetAllProjectTaskListsContextFunc.
+func (mock *Client) GetAllProjectTaskListsContext(ctx context.Context, id string, ops *teamwork.GetProjectTaskListsOps) (teamwork.TaskLists, error) {
+	mock.calls.record("GetAllProjectTaskListsContext", ctx, id, ops)
+	if mock.GetAllProjectTaskListsContextFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectTaskListsContextFunc is not set")
+	}
+	return mock.GetAllProjectTaskListsContextFunc(ctx, id, ops)
+}
+
+// GetTaskList calls GetTaskListFunc.
+func (mock *Client) GetTaskList(id string) (teamwork.TaskList, error) {
+	mock.calls.record("GetTaskList", id)
+	if mock.GetTaskListFunc == nil {
+		panic("teamworkmock: Client.GetTaskListFunc is not set")
+	}
+	return mock.GetTaskListFunc(id)
+}
+
+// GetTaskListContext calls GetTaskListContextFunc.
+func (mock *Client) GetTaskListContext(ctx context.Context, id string) (teamwork.TaskList, error) {
+	mock.calls.record("GetTaskListContext", ctx, id)
+	if mock.GetTaskListContextFunc == nil {
+		panic("teamworkmock: Client.GetTaskListContextFunc is not set")
+	}
+	return mock.GetTaskListContextFunc(ctx, id)
+}
+
+// CreateTaskList calls CreateTaskListFunc.
+func (mock *Client) CreateTaskList(projectID string, ops *teamwork.CreateTaskListOps) (*teamwork.CreateTaskListResponse, error) {
+	mock.calls.record("CreateTaskList", projectID, ops)
+	if mock.CreateTaskListFunc == nil {
+		panic("teamworkmock: Client.CreateTaskListFunc is not set")
+	}
+	return mock.CreateTaskListFunc(projectID, ops)
+}
+
+// CreateTaskListContext calls CreateTaskListContextFunc.
+func (mock *Client) CreateTaskListContext(ctx context.Context, projectID string, ops *teamwork.CreateTaskListOps) (*teamwork.CreateTaskListResponse, error) {
+	mock.calls.record("CreateTaskListContext", ctx, projectID, ops)
+	if mock.CreateTaskListContextFunc == nil {
+		panic("teamworkmock: Client.CreateTaskListContextFunc is not set")
+	}
+	return mock.CreateTaskListContextFunc(ctx, projectID, ops)
+}
+
+// CreateTaskListFromTemplate calls CreateTaskListFromTemplateFunc.
+func (mock *Client) CreateTaskListFromTemplate(projectID string, ops *teamwork.CreateTaskListFromTemplateOps) (*teamwork.CreateTaskListResponse, error) {
+	mock.calls.record("CreateTaskListFromTemplate", projectID, ops)
+	if mock.CreateTaskListFromTemplateFunc == nil {
+		panic("teamworkmock: Client.CreateTaskListFromTemplateFunc is not set")
+	}
+	return mock.CreateTaskListFromTemplateFunc(projectID, ops)
+}
+
+// CreateTaskListFromTemplateContext calls CreateTaskListFromTemplateContextFunc.
+func (mock *Client) CreateTaskListFromTemplateContext(ctx context.Context, projectID string, ops *teamwork.CreateTaskListFromTemplateOps) (*teamwork.CreateTaskListResponse, error) {
+	mock.calls.record("CreateTaskListFromTemplateContext", ctx, projectID, ops)
+	if mock.CreateTaskListFromTemplateContextFunc == nil {
+		panic("teamworkmock: Client.CreateTaskListFromTemplateContextFunc is not set")
+	}
+	return mock.CreateTaskListFromTemplateContextFunc(ctx, projectID, ops)
+}
+
+// UpdateTaskList calls UpdateTaskListFunc.
+func (mock *Client) UpdateTaskList(id string, ops *teamwork.UpdateTaskListOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateTaskList", id, ops)
+	if mock.UpdateTaskListFunc == nil {
+		panic("teamworkmock: Client.UpdateTaskListFunc is not set")
+	}
+	return mock.UpdateTaskListFunc(id, ops)
+}
+
+// UpdateTaskListContext calls UpdateTaskListContextFunc.
+func (mock *Client) UpdateTaskListContext(ctx context.Context, id string, ops *teamwork.UpdateTaskListOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateTaskListContext", ctx, id, ops)
+	if mock.UpdateTaskListContextFunc == nil {
+		panic("teamworkmock: Client.UpdateTaskListContextFunc is not set")
+	}
+	return mock.UpdateTaskListContextFunc(ctx, id, ops)
+}
+
+// DeleteTaskList calls DeleteTaskListFunc.
+func (mock *Client) DeleteTaskList(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteTaskList", id)
+	if mock.DeleteTaskListFunc == nil {
+		panic("teamworkmock: Client.DeleteTaskListFunc is not set")
+	}
+	return mock.DeleteTaskListFunc(id)
+}
+
+// DeleteTaskListContext calls DeleteTaskListContextFunc.
+func (mock *Client) DeleteTaskListContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteTaskListContext", ctx, id)
+	if mock.DeleteTaskListContextFunc == nil {
+		panic("teamworkmock: Client.DeleteTaskListContextFunc is not set")
+	}
+	return mock.DeleteTaskListContextFunc(ctx, id)
+}
+
+// CompleteTaskList calls CompleteTaskListFunc.
+func (mock *Client) CompleteTaskList(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("CompleteTaskList", id)
+	if mock.CompleteTaskListFunc == nil {
+		panic("teamworkmock: Client.CompleteTaskListFunc is not set")
+	}
+	return mock.CompleteTaskListFunc(id)
+}
+
+// CompleteTaskListContext calls CompleteTaskListContextFunc.
+func (mock *Client) CompleteTaskListContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("CompleteTaskListContext", ctx, id)
+	if mock.CompleteTaskListContextFunc == nil {
+		panic("teamworkmock: Client.CompleteTaskListContextFunc is not set")
+	}
+	return mock.CompleteTaskListContextFunc(ctx, id)
+}
+
+// ReorderTaskLists calls ReorderTaskListsFunc.
+func (mock *Client) ReorderTaskLists(projectID string, taskListIDs []string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("ReorderTaskLists", projectID, taskListIDs)
+	if mock.ReorderTaskListsFunc == nil {
+		panic("teamworkmock: Client.ReorderTaskListsFunc is not set")
+	}
+	return mock.ReorderTaskListsFunc(projectID, taskListIDs)
+}
+
+// ReorderTaskListsContext calls ReorderTaskListsContextFunc.
+func (mock *Client) ReorderTaskListsContext(ctx context.Context, projectID string, taskListIDs []string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("ReorderTaskListsContext", ctx, projectID, taskListIDs)
+	if mock.ReorderTaskListsContextFunc == nil {
+		panic("teamworkmock: Client.ReorderTaskListsContextFunc is not set")
+	}
+	return mock.ReorderTaskListsContextFunc(ctx, projectID, taskListIDs)
+}
+
+// GetMilestones calls GetMilestonesFunc.
+func (mock *Client) GetMilestones(ops *teamwork.GetMilestonesOps) (teamwork.Milestones, teamwork.Pages, error) {
+	mock.calls.record("GetMilestones", ops)
+	if mock.GetMilestonesFunc == nil {
+		panic("teamworkmock: Client.GetMilestonesFunc is not set")
+	}
+	return mock.GetMilestonesFunc(ops)
+}
+
+// GetMilestonesContext calls GetMilestonesContextFunc.
+func (mock *Client) GetMilestonesContext(ctx context.Context, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, teamwork.Pages, error) {
+	mock.calls.record("GetMilestonesContext", ctx, ops)
+	if mock.GetMilestonesContextFunc == nil {
+		panic("teamworkmock: Client.GetMilestonesContextFunc is not set")
+	}
+	return mock.GetMilestonesContextFunc(ctx, ops)
+}
+
+// AllMilestones calls AllMilestonesFunc.
+func (mock *Client) AllMilestones(ctx context.Context, ops *teamwork.GetMilestonesOps) iter.Seq2[teamwork.Milestone, error] {
+	mock.calls.record("AllMilestones", ctx, ops)
+	if mock.AllMilestonesFunc == nil {
+		panic("teamworkmock: Client.AllMilestonesFunc is not set")
+	}
+	return mock.AllMilestonesFunc(ctx, ops)
+}
+
+// GetAllMilestones calls GetAllMilestonesFunc.
+func (mock *Client) GetAllMilestones(ops *teamwork.GetMilestonesOps) (teamwork.Milestones, error) {
+	mock.calls.record("GetAllMilestones", ops)
+	if mock.GetAllMilestonesFunc == nil {
+		panic("teamworkmock: Client.GetAllMilestonesFunc is not set")
+	}
+	return mock.GetAllMilestonesFunc(ops)
+}
+
+// GetAllMilestonesContext calls GetAllMilestonesContextFunc.
+func (mock *Client) GetAllMilestonesContext(ctx context.Context, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, error) {
+	mock.calls.record("GetAllMilestonesContext", ctx, ops)
+	if mock.GetAllMilestonesContextFunc == nil {
+		panic("teamworkmock: Client.GetAllMilestonesContextFunc is not set")
+	}
+	return mock.GetAllMilestonesContextFunc(ctx, ops)
+}
+
+// GetProjectMilestones calls GetProjectMilestonesFunc.
+func (mock *Client) GetProjectMilestones(id string, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, teamwork.Pages, error) {
+	mock.calls.record("GetProjectMilestones", id, ops)
+	if mock.GetProjectMilestonesFunc == nil {
+		panic("teamworkmock: Client.GetProjectMilestonesFunc is not set")
+	}
+	return mock.GetProjectMilestonesFunc(id, ops)
+}
+
+// GetProjectMilestonesContext calls GetProjectMilestonesContextFunc.
+func (mock *Client) GetProjectMilestonesContext(ctx context.Context, id string, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, teamwork.Pages, error) {
+	mock.calls.record("GetProjectMilestonesContext", ctx, id, ops)
+	if mock.GetProjectMilestonesContextFunc == nil {
+		panic("teamworkmock: Client.GetProjectMilestonesContextFunc is not set")
+	}
+	return mock.GetProjectMilestonesContextFunc(ctx, id, ops)
+}
+
+// AllProjectMilestones calls AllProjectMilestonesFunc.
+func (mock *Client) AllProjectMilestones(ctx context.Context, id string, ops *teamwork.GetMilestonesOps) iter.Seq2[teamwork.Milestone, error] {
+	mock.calls.record("AllProjectMilestones", ctx, id, ops)
+	if mock.AllProjectMilestonesFunc == nil {
+		panic("teamworkmock: Client.AllProjectMilestonesFunc is not set")
+	}
+	return mock.AllProjectMilestonesFunc(ctx, id, ops)
+}
+
+// GetAllProjectMilestones calls GetAllProjectMilestonesFunc.
+func (mock *Client) GetAllProjectMilestones(id string, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, error) {
+	mock.calls.record("GetAllProjectMilestones", id, ops)
+	if mock.GetAllProjectMilestonesFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectMilestonesFunc is not set")
+	}
+	return mock.GetAllProjectMilestonesFunc(id, ops)
+}
+
+// GetAllProjectMilestonesContext calls GetAllProjectMilestonesContextFunc.
+func (mock *Client) GetAllProjectMilestonesContext(ctx context.Context, id string, ops *teamwork.GetMilestonesOps) (teamwork.Milestones, error) {
+	mock.calls.record("GetAllProjectMilestonesContext", ctx, id, ops)
+	if mock.GetAllProjectMilestonesContextFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectMilestonesContextFunc is not set")
+	}
+	return mock.GetAllProjectMilestonesContextFunc(ctx, id, ops)
+}
+
+// GetMilestone calls GetMilestoneFunc.
+func (mock *Client) GetMilestone(id string) (teamwork.Milestone, error) {
+	mock.calls.record("GetMilestone", id)
+	if mock.GetMilestoneFunc == nil {
+		panic("teamworkmock: Client.GetMilestoneFunc is not set")
+	}
+	return mock.GetMilestoneFunc(id)
+}
+
+// GetMilestoneContext calls GetMilestoneContextFunc.
+func (mock *Client) GetMilestoneContext(ctx context.Context, id string) (teamwork.Milestone, error) {
+	mock.calls.record("GetMilestoneContext", ctx, id)
+	if mock.GetMilestoneContextFunc == nil {
+		panic("teamworkmock: Client.GetMilestoneContextFunc is not set")
+	}
+	return mock.GetMilestoneContextFunc(ctx, id)
+}
+
+// CreateMilestone calls CreateMilestoneFunc.
+func (mock *Client) CreateMilestone(projectID string, ops *teamwork.CreateMilestoneOps) (*teamwork.CreateMilestoneResponse, error) {
+	mock.calls.record("CreateMilestone", projectID, ops)
+	if mock.CreateMilestoneFunc == nil {
+		panic("teamworkmock: Client.CreateMilestoneFunc is not set")
+	}
+	return mock.CreateMilestoneFunc(projectID, ops)
+}
+
+// CreateMilestoneContext calls CreateMilestoneContextFunc.
+func (mock *Client) CreateMilestoneContext(ctx context.Context, projectID string, ops *teamwork.CreateMilestoneOps) (*teamwork.CreateMilestoneResponse, error) {
+	mock.calls.record("CreateMilestoneContext", ctx, projectID, ops)
+	if mock.CreateMilestoneContextFunc == nil {
+		panic("teamworkmock: Client.CreateMilestoneContextFunc is not set")
+	}
+	return mock.CreateMilestoneContextFunc(ctx, projectID, ops)
+}
+
+// UpdateMilestone calls UpdateMilestoneFunc.
+func (mock *Client) UpdateMilestone(id string, ops *teamwork.UpdateMilestoneOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateMilestone", id, ops)
+	if mock.UpdateMilestoneFunc == nil {
+		panic("teamworkmock: Client.UpdateMilestoneFunc is not set")
+	}
+	return mock.UpdateMilestoneFunc(id, ops)
+}
+
+// UpdateMilestoneContext calls UpdateMilestoneContextFunc.
+func (mock *Client) UpdateMilestoneContext(ctx context.Context, id string, ops *teamwork.UpdateMilestoneOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateMilestoneContext", ctx, id, ops)
+	if mock.UpdateMilestoneContextFunc == nil {
+		panic("teamworkmock: Client.UpdateMilestoneContextFunc is not set")
+	}
+	return mock.UpdateMilestoneContextFunc(ctx, id, ops)
+}
+
+// CompleteMilestone calls CompleteMilestoneFunc.
+func (mock *Client) CompleteMilestone(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("CompleteMilestone", id)
+	if mock.CompleteMilestoneFunc == nil {
+		panic("teamworkmock: Client.CompleteMilestoneFunc is not set")
+	}
+	return mock.CompleteMilestoneFunc(id)
+}
+
+// CompleteMilestoneContext calls CompleteMilestoneContextFunc.
+func (mock *Client) CompleteMilestoneContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("CompleteMilestoneContext", ctx, id)
+	if mock.CompleteMilestoneContextFunc == nil {
+		panic("teamworkmock: Client.CompleteMilestoneContextFunc is not set")
+	}
+	return mock.CompleteMilestoneContextFunc(ctx, id)
+}
+
+// UncompleteMilestone calls UncompleteMilestoneFunc.
+func (mock *Client) UncompleteMilestone(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UncompleteMilestone", id)
+	if mock.UncompleteMilestoneFunc == nil {
+		panic("teamworkmock: Client.UncompleteMilestoneFunc is not set")
+	}
+	return mock.UncompleteMilestoneFunc(id)
+}
+
+// UncompleteMilestoneContext calls UncompleteMilestoneContextFunc.
+func (mock *Client) UncompleteMilestoneContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UncompleteMilestoneContext", ctx, id)
+	if mock.UncompleteMilestoneContextFunc == nil {
+		panic("teamworkmock: Client.UncompleteMilestoneContextFunc is not set")
+	}
+	return mock.UncompleteMilestoneContextFunc(ctx, id)
+}
+
+// DeleteMilestone calls DeleteMilestoneFunc.
+func (mock *Client) DeleteMilestone(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteMilestone", id)
+	if mock.DeleteMilestoneFunc == nil {
+		panic("teamworkmock: Client.DeleteMilestoneFunc is not set")
+	}
+	return mock.DeleteMilestoneFunc(id)
+}
+
+// DeleteMilestoneContext calls DeleteMilestoneContextFunc.
+func (mock *Client) DeleteMilestoneContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteMilestoneContext", ctx, id)
+	if mock.DeleteMilestoneContextFunc == nil {
+		panic("teamworkmock: Client.DeleteMilestoneContextFunc is not set")
+	}
+	return mock.DeleteMilestoneContextFunc(ctx, id)
+}
+
+// GetComments calls GetCommentsFunc.
+func (mock *Client) GetComments(resourceType string, id string, ops *teamwork.GetCommentsOps) (teamwork.Comments, teamwork.Pages, error) {
+	mock.calls.record("GetComments", resourceType, id, ops)
+	if mock.GetCommentsFunc == nil {
+		panic("teamworkmock: Client.GetCommentsFunc is not set")
+	}
+	return mock.GetCommentsFunc(resourceType, id, ops)
+}
+
+// GetCommentsContext calls GetCommentsContextFunc.
+func (mock *Client) GetCommentsContext(ctx context.Context, resourceType string, id string, ops *teamwork.GetCommentsOps) (teamwork.Comments, teamwork.Pages, error) {
+	mock.calls.record("GetCommentsContext", ctx, resourceType, id, ops)
+	if mock.GetCommentsContextFunc == nil {
+		panic("teamworkmock: Client.GetCommentsContextFunc is not set")
+	}
+	return mock.GetCommentsContextFunc(ctx, resourceType, id, ops)
+}
+
+// AllComments calls AllCommentsFunc.
+func (mock *Client) AllComments(ctx context.Context, resourceType string, id string, ops *teamwork.GetCommentsOps) iter.Seq2[teamwork.Comment, error] {
+	mock.calls.record("AllComments", ctx, resourceType, id, ops)
+	if mock.AllCommentsFunc == nil {
+		panic("teamworkmock: Client.AllCommentsFunc is not set")
+	}
+	return mock.AllCommentsFunc(ctx, resourceType, id, ops)
+}
+
+// GetAllComments calls GetAllCommentsFunc.
+func (mock *Client) GetAllComments(resourceType string, id string, ops *teamwork.GetCommentsOps) (teamwork.Comments, error) {
+	mock.calls.record("GetAllComments", resourceType, id, ops)
+	if mock.GetAllCommentsFunc == nil {
+		panic("teamworkmock: Client.GetAllCommentsFunc is not set")
+	}
+	return mock.GetAllCommentsFunc(resourceType, id, ops)
+}
+
+// GetAllCommentsContext calls GetAllCommentsContextFunc.
+func (mock *Client) GetAllCommentsContext(ctx context.Context, resourceType string, id string, ops *teamwork.GetCommentsOps) (teamwork.Comments, error) {
+	mock.calls.record("GetAllCommentsContext", ctx, resourceType, id, ops)
+	if mock.GetAllCommentsContextFunc == nil {
+		panic("teamworkmock: Client.GetAllCommentsContextFunc is not set")
+	}
+	return mock.GetAllCommentsContextFunc(ctx, resourceType, id, ops)
+}
+
+// CreateComment calls CreateCommentFunc.
+func (mock *Client) CreateComment(resourceType string, id string, ops *teamwork.CreateCommentOps) (*teamwork.CreateCommentResponse, error) {
+	mock.calls.record("CreateComment", resourceType, id, ops)
+	if mock.CreateCommentFunc == nil {
+		panic("teamworkmock: Client.CreateCommentFunc is not set")
+	}
+	return mock.CreateCommentFunc(resourceType, id, ops)
+}
+
+// CreateCommentContext calls CreateCommentContextFunc.
+func (mock *Client) CreateCommentContext(ctx context.Context, resourceType string, id string, ops *teamwork.CreateCommentOps) (*teamwork.CreateCommentResponse, error) {
+	mock.calls.record("CreateCommentContext", ctx, resourceType, id, ops)
+	if mock.CreateCommentContextFunc == nil {
+		panic("teamworkmock: Client.CreateCommentContextFunc is not set")
+	}
+	return mock.CreateCommentContextFunc(ctx, resourceType, id, ops)
+}
+
+// UpdateComment calls UpdateCommentFunc.
+func (mock *Client) UpdateComment(id string, ops *teamwork.UpdateCommentOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateComment", id, ops)
+	if mock.UpdateCommentFunc == nil {
+		panic("teamworkmock: Client.UpdateCommentFunc is not set")
+	}
+	return mock.UpdateCommentFunc(id, ops)
+}
+
+// UpdateCommentContext calls UpdateCommentContextFunc.
+func (mock *Client) UpdateCommentContext(ctx context.Context, id string, ops *teamwork.UpdateCommentOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateCommentContext", ctx, id, ops)
+	if mock.UpdateCommentContextFunc == nil {
+		panic("teamworkmock: Client.UpdateCommentContextFunc is not set")
+	}
+	return mock.UpdateCommentContextFunc(ctx, id, ops)
+}
+
+// DeleteComment calls DeleteCommentFunc.
+func (mock *Client) DeleteComment(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteComment", id)
+	if mock.DeleteCommentFunc == nil {
+		panic("teamworkmock: Client.DeleteCommentFunc is not set")
+	}
+	return mock.DeleteCommentFunc(id)
+}
+
+// DeleteCommentContext calls DeleteCommentContextFunc.
+func (mock *Client) DeleteCommentContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("DeleteCommentContext", ctx, id)
+	if mock.DeleteCommentContextFunc == nil {
+		panic("teamworkmock: Client.DeleteCommentContextFunc is not set")
+	}
+	return mock.DeleteCommentContextFunc(ctx, id)
+}
+
+// MarkCommentRead calls MarkCommentReadFunc.
+func (mock *Client) MarkCommentRead(id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("MarkCommentRead", id)
+	if mock.MarkCommentReadFunc == nil {
+		panic("teamworkmock: Client.MarkCommentReadFunc is not set")
+	}
+	return mock.MarkCommentReadFunc(id)
+}
+
+// MarkCommentReadContext calls MarkCommentReadContextFunc.
+func (mock *Client) MarkCommentReadContext(ctx context.Context, id string) (*teamwork.StatusResponse, error) {
+	mock.calls.record("MarkCommentReadContext", ctx, id)
+	if mock.MarkCommentReadContextFunc == nil {
+		panic("teamworkmock: Client.MarkCommentReadContextFunc is not set")
+	}
+	return mock.MarkCommentReadContextFunc(ctx, id)
+}
+
+// UploadPendingFile calls UploadPendingFileFunc.
+func (mock *Client) UploadPendingFile(filename string, file io.Reader) (teamwork.PendingFile, error) {
+	mock.calls.record("UploadPendingFile", filename, file)
+	if mock.UploadPendingFileFunc == nil {
+		panic("teamworkmock: Client.UploadPendingFileFunc is not set")
+	}
+	return mock.UploadPendingFileFunc(filename, file)
+}
+
+// UploadPendingFileContext calls UploadPendingFileContextFunc.
+func (mock *Client) UploadPendingFileContext(ctx context.Context, filename string, file io.Reader) (teamwork.PendingFile, error) {
+	mock.calls.record("UploadPendingFileContext", ctx, filename, file)
+	if mock.UploadPendingFileContextFunc == nil {
+		panic("teamworkmock: Client.UploadPendingFileContextFunc is not set")
+	}
+	return mock.UploadPendingFileContextFunc(ctx, filename, file)
+}
+
+// GetTimeEntries calls GetTimeEntriesFunc.
+func (mock *Client) GetTimeEntries(ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error) {
+	mock.calls.record("GetTimeEntries", ops)
+	if mock.GetTimeEntriesFunc == nil {
+		panic("teamworkmock: Client.GetTimeEntriesFunc is not set")
+	}
+	return mock.GetTimeEntriesFunc(ops)
+}
+
+// GetTimeEntriesContext calls GetTimeEntriesContextFunc.
+func (mock *Client) GetTimeEntriesContext(ctx context.Context, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error) {
+	mock.calls.record("GetTimeEntriesContext", ctx, ops)
+	if mock.GetTimeEntriesContextFunc == nil {
+		panic("teamworkmock: Client.GetTimeEntriesContextFunc is not set")
+	}
+	return mock.GetTimeEntriesContextFunc(ctx, ops)
+}
+
+// AllTimeEntries calls AllTimeEntriesFunc.
+func (mock *Client) AllTimeEntries(ctx context.Context, ops *teamwork.GetTimeEntriesOps) iter.Seq2[teamwork.TimeEntry, error] {
+	mock.calls.record("AllTimeEntries", ctx, ops)
+	if mock.AllTimeEntriesFunc == nil {
+		panic("teamworkmock: Client.AllTimeEntriesFunc is not set")
+	}
+	return mock.AllTimeEntriesFunc(ctx, ops)
+}
+
+// GetAllTimeEntries calls GetAllTimeEntriesFunc.
+func (mock *Client) GetAllTimeEntries(ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error) {
+	mock.calls.record("GetAllTimeEntries", ops)
+	if mock.GetAllTimeEntriesFunc == nil {
+		panic("teamworkmock: Client.GetAllTimeEntriesFunc is not set")
+	}
+	return mock.GetAllTimeEntriesFunc(ops)
+}
+
+// GetAllTimeEntriesContext calls GetAllTimeEntriesContextFunc.
+func (mock *Client) GetAllTimeEntriesContext(ctx context.Context, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error) {
+	mock.calls.record("GetAllTimeEntriesContext", ctx, ops)
+	if mock.GetAllTimeEntriesContextFunc == nil {
+		panic("teamworkmock: Client.GetAllTimeEntriesContextFunc is not set")
+	}
+	return mock.GetAllTimeEntriesContextFunc(ctx, ops)
+}
+
+// GetProjectTimeEntries calls GetProjectTimeEntriesFunc.
+func (mock *Client) GetProjectTimeEntries(id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error) {
+	mock.calls.record("GetProjectTimeEntries", id, ops)
+	if mock.GetProjectTimeEntriesFunc == nil {
+		panic("teamworkmock: Client.GetProjectTimeEntriesFunc is not set")
+	}
+	return mock.GetProjectTimeEntriesFunc(id, ops)
+}
+
+// GetProjectTimeEntriesContext calls GetProjectTimeEntriesContextFunc.
+func (mock *Client) GetProjectTimeEntriesContext(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error) {
+	mock.calls.record("GetProjectTimeEntriesContext", ctx, id, ops)
+	if mock.GetProjectTimeEntriesContextFunc == nil {
+		panic("teamworkmock: Client.GetProjectTimeEntriesContextFunc is not set")
+	}
+	return mock.GetProjectTimeEntriesContextFunc(ctx, id, ops)
+}
+
+// AllProjectTimeEntries calls AllProjectTimeEntriesFunc.
+func (mock *Client) AllProjectTimeEntries(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) iter.Seq2[teamwork.TimeEntry, error] {
+	mock.calls.record("AllProjectTimeEntries", ctx, id, ops)
+	if mock.AllProjectTimeEntriesFunc == nil {
+		panic("teamworkmock: Client.AllProjectTimeEntriesFunc is not set")
+	}
+	return mock.AllProjectTimeEntriesFunc(ctx, id, ops)
+}
+
+// GetAllProjectTimeEntries calls GetAllProjectTimeEntriesFunc.
+func (mock *Client) GetAllProjectTimeEntries(id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error) {
+	mock.calls.record("GetAllProjectTimeEntries", id, ops)
+	if mock.GetAllProjectTimeEntriesFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectTimeEntriesFunc is not set")
+	}
+	return mock.GetAllProjectTimeEntriesFunc(id, ops)
+}
+
+// GetAllProjectTimeEntriesContext calls GetAllProjectTimeEntriesContextFunc.
+func (mock *Client) GetAllProjectTimeEntriesContext(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error) {
+	mock.calls.record("GetAllProjectTimeEntriesContext", ctx, id, ops)
+	if mock.GetAllProjectTimeEntriesContextFunc == nil {
+		panic("teamworkmock: Client.GetAllProjectTimeEntriesContextFunc is not set")
+	}
+	return mock.GetAllProjectTimeEntriesContextFunc(ctx, id, ops)
+}
+
+// CreateTimeEntryForProject calls CreateTimeEntryForProjectFunc.
+func (mock *Client) CreateTimeEntryForProject(projectID string, ops *teamwork.CreateTimeEntryOps) (*teamwork.CreateTimeEntryResponse, error) {
+	mock.calls.record("CreateTimeEntryForProject", projectID, ops)
+	if mock.CreateTimeEntryForProjectFunc == nil {
+		panic("teamworkmock: Client.CreateTimeEntryForProjectFunc is not set")
+	}
+	return mock.CreateTimeEntryForProjectFunc(projectID, ops)
+}
+
+// CreateTimeEntryForProjectContext calls CreateTimeEntryForProjectContextFunc.
+func (mock *Client) CreateTimeEntryForProjectContext(ctx context.Context, projectID string, ops *teamwork.CreateTimeEntryOps) (*teamwork.CreateTimeEntryResponse, error) {
+	mock.calls.record("CreateTimeEntryForProjectContext", ctx, projectID, ops)
+	if mock.CreateTimeEntryForProjectContextFunc == nil {
+		panic("teamworkmock: Client.CreateTimeEntryForProjectContextFunc is not set")
+	}
+	return mock.CreateTimeEntryForProjectContextFunc(ctx, projectID, ops)
+}
+
+// CreateTimeEntryForTask calls CreateTimeEntryForTaskFunc.
+func (mock *Client) CreateTimeEntryForTask(taskID string, ops *teamwork.CreateTimeEntryOps) (*teamwork.CreateTimeEntryResponse, error) {
+	mock.calls.record("CreateTimeEntryForTask", taskID, ops)
+	if mock.CreateTimeEntryForTaskFunc == nil {
+		panic("teamworkmock: Client.CreateTimeEntryForTaskFunc is not set")
+	}
+	return mock.CreateTimeEntryForTaskFunc(taskID, ops)
+}
+
+// CreateTimeEntryForTaskContext calls CreateTimeEntryForTaskContextFunc.
+func (mock *Client) CreateTimeEntryForTaskContext(ctx context.Context, taskID string, ops *teamwork.CreateTimeEntryOps) (*teamwork.CreateTimeEntryResponse, error) {
+	mock.calls.record("CreateTimeEntryForTaskContext", ctx, taskID, ops)
+	if mock.CreateTimeEntryForTaskContextFunc == nil {
+		panic("teamworkmock: Client.CreateTimeEntryForTaskContextFunc is not set")
+	}
+	return mock.CreateTimeEntryForTaskContextFunc(ctx, taskID, ops)
+}
+
+// GetTimeEntry calls GetTimeEntryFunc.
+func (mock *Client) GetTimeEntry(id string) (teamwork.TimeEntry, error) {
+	mock.calls.record("GetTimeEntry", id)
+	if mock.GetTimeEntryFunc == nil {
+		panic("teamworkmock: Client.GetTimeEntryFunc is not set")
+	}
+	return mock.GetTimeEntryFunc(id)
+}
+
+// GetTimeEntryContext calls GetTimeEntryContextFunc.
+func (mock *Client) GetTimeEntryContext(ctx context.Context, id string) (teamwork.TimeEntry, error) {
+	mock.calls.record("GetTimeEntryContext", ctx, id)
+	if mock.GetTimeEntryContextFunc == nil {
+		panic("teamworkmock: Client.GetTimeEntryContextFunc is not set")
+	}
+	return mock.GetTimeEntryContextFunc(ctx, id)
+}
+
+// UpdateTimeEntry calls UpdateTimeEntryFunc.
+func (mock *Client) UpdateTimeEntry(id string, ops *teamwork.UpdateTimeEntryOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateTimeEntry", id, ops)
+	if mock.UpdateTimeEntryFunc == nil {
+		panic("teamworkmock: Client.UpdateTimeEntryFunc is not set")
+	}
+	return mock.UpdateTimeEntryFunc(id, ops)
+}
+
+// UpdateTimeEntryContext calls UpdateTimeEntryContextFunc.
+func (mock *Client) UpdateTimeEntryContext(ctx context.Context, id string, ops *teamwork.UpdateTimeEntryOps) (*teamwork.StatusResponse, error) {
+	mock.calls.record("UpdateTimeEntryContext", ctx, id, ops)
+	if mock.UpdateTimeEntryContextFunc == nil {
+		panic("teamworkmock: Client.UpdateTimeEntryContextFunc is not set")
+	}
+	return mock.UpdateTimeEntryContextFunc(ctx, id, ops)
+}
+
+// DeleteTimeEntry calls DeleteTimeEntryFunc.
+func (mock *Client) DeleteTimeEntry(id string) (*teamwork.DeleteTimeEntryResponse, error) {
+	mock.calls.record("DeleteTimeEntry", id)
+	if mock.DeleteTimeEntryFunc == nil {
+		panic("teamworkmock: Client.DeleteTimeEntryFunc is not set")
+	}
+	return mock.DeleteTimeEntryFunc(id)
+}
+
+// DeleteTimeEntryContext calls DeleteTimeEntryContextFunc.
+func (mock *Client) DeleteTimeEntryContext(ctx context.Context, id string) (*teamwork.DeleteTimeEntryResponse, error) {
+	mock.calls.record("DeleteTimeEntryContext", ctx, id)
+	if mock.DeleteTimeEntryContextFunc == nil {
+		panic("teamworkmock: Client.DeleteTimeEntryContextFunc is not set")
+	}
+	return mock.DeleteTimeEntryContextFunc(ctx, id)
+}
+
+// GetTaskTimeEntries calls GetTaskTimeEntriesFunc.
+func (mock *Client) GetTaskTimeEntries(id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error) {
+	mock.calls.record("GetTaskTimeEntries", id, ops)
+	if mock.GetTaskTimeEntriesFunc == nil {
+		panic("teamworkmock: Client.GetTaskTimeEntriesFunc is not set")
+	}
+	return mock.GetTaskTimeEntriesFunc(id, ops)
+}
+
+// GetTaskTimeEntriesContext calls GetTaskTimeEntriesContextFunc.
+func (mock *Client) GetTaskTimeEntriesContext(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, teamwork.Pages, error) {
+	mock.calls.record("GetTaskTimeEntriesContext", ctx, id, ops)
+	if mock.GetTaskTimeEntriesContextFunc == nil {
+		panic("teamworkmock: Client.GetTaskTimeEntriesContextFunc is not set")
+	}
+	return mock.GetTaskTimeEntriesContextFunc(ctx, id, ops)
+}
+
+// AllTaskTimeEntries calls AllTaskTimeEntriesFunc.
+func (mock *Client) AllTaskTimeEntries(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) iter.Seq2[teamwork.TimeEntry, error] {
+	mock.calls.record("AllTaskTimeEntries", ctx, id, ops)
+	if mock.AllTaskTimeEntriesFunc == nil {
+		panic("teamworkmock: Client.AllTaskTimeEntriesFunc is not set")
+	}
+	return mock.AllTaskTimeEntriesFunc(ctx, id, ops)
+}
+
+// GetAllTaskTimeEntries calls GetAllTaskTimeEntriesFunc.
+func (mock *Client) GetAllTaskTimeEntries(id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error) {
+	mock.calls.record("GetAllTaskTimeEntries", id, ops)
+	if mock.GetAllTaskTimeEntriesFunc == nil {
+		panic("teamworkmock: Client.GetAllTaskTimeEntriesFunc is not set")
+	}
+	return mock.GetAllTaskTimeEntriesFunc(id, ops)
+}
+
+// GetAllTaskTimeEntriesContext calls GetAllTaskTimeEntriesContextFunc.
+func (mock *Client) GetAllTaskTimeEntriesContext(ctx context.Context, id string, ops *teamwork.GetTimeEntriesOps) (teamwork.TimeEntries, error) {
+	mock.calls.record("GetAllTaskTimeEntriesContext", ctx, id, ops)
+	if mock.GetAllTaskTimeEntriesContextFunc == nil {
+		panic("teamworkmock: Client.GetAllTaskTimeEntriesContextFunc is not set")
+	}
+	return mock.GetAllTaskTimeEntriesContextFunc(ctx, id, ops)
+}
+
+// GetTotalTime calls GetTotalTimeFunc.
+func (mock *Client) GetTotalTime(ops *teamwork.GetTotalTimeOps) (teamwork.TotalTime, error) {
+	mock.calls.record("GetTotalTime", ops)
+	if mock.GetTotalTimeFunc == nil {
+		panic("teamworkmock: Client.GetTotalTimeFunc is not set")
+	}
+	return mock.GetTotalTimeFunc(ops)
+}
+
+// GetTotalTimeContext calls GetTotalTimeContextFunc.
+func (mock *Client) GetTotalTimeContext(ctx context.Context, ops *teamwork.GetTotalTimeOps) (teamwork.TotalTime, error) {
+	mock.calls.record("GetTotalTimeContext", ctx, ops)
+	if mock.GetTotalTimeContextFunc == nil {
+		panic("teamworkmock: Client.GetTotalTimeContextFunc is not set")
+	}
+	return mock.GetTotalTimeContextFunc(ctx, ops)
+}
+
+// GetProjectTotalTime calls GetProjectTotalTimeFunc.
+func (mock *Client) GetProjectTotalTime(id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTotalTimes, error) {
+	mock.calls.record("GetProjectTotalTime", id, ops)
+	if mock.GetProjectTotalTimeFunc == nil {
+		panic("teamworkmock: Client.GetProjectTotalTimeFunc is not set")
+	}
+	return mock.GetProjectTotalTimeFunc(id, ops)
+}
+
+// GetProjectTotalTimeContext calls GetProjectTotalTimeContextFunc.
+func (mock *Client) GetProjectTotalTimeContext(ctx context.Context, id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTotalTimes, error) {
+	mock.calls.record("GetProjectTotalTimeContext", ctx, id, ops)
+	if mock.GetProjectTotalTimeContextFunc == nil {
+		panic("teamworkmock: Client.GetProjectTotalTimeContextFunc is not set")
+	}
+	return mock.GetProjectTotalTimeContextFunc(ctx, id, ops)
+}
+
+// GetTaskListTotalTime calls GetTaskListTotalTimeFunc.
+func (mock *Client) GetTaskListTotalTime(id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTaskListTotalTimes, error) {
+	mock.calls.record("GetTaskListTotalTime", id, ops)
+	if mock.GetTaskListTotalTimeFunc == nil {
+		panic("teamworkmock: Client.GetTaskListTotalTimeFunc is not set")
+	}
+	return mock.GetTaskListTotalTimeFunc(id, ops)
+}
+
+// GetTaskListTotalTimeContext calls GetTaskListTotalTimeContextFunc.
+func (mock *Client) GetTaskListTotalTimeContext(ctx context.Context, id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTaskListTotalTimes, error) {
+	mock.calls.record("GetTaskListTotalTimeContext", ctx, id, ops)
+	if mock.GetTaskListTotalTimeContextFunc == nil {
+		panic("teamworkmock: Client.GetTaskListTotalTimeContextFunc is not set")
+	}
+	return mock.GetTaskListTotalTimeContextFunc(ctx, id, ops)
+}
+
+// GetTaskTotalTime calls GetTaskTotalTimeFunc.
+func (mock *Client) GetTaskTotalTime(id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTaskTotalTimes, error) {
+	mock.calls.record("GetTaskTotalTime", id, ops)
+	if mock.GetTaskTotalTimeFunc == nil {
+		panic("teamworkmock: Client.GetTaskTotalTimeFunc is not set")
+	}
+	return mock.GetTaskTotalTimeFunc(id, ops)
+}
+
+// GetTaskTotalTimeContext calls GetTaskTotalTimeContextFunc.
+func (mock *Client) GetTaskTotalTimeContext(ctx context.Context, id string, ops *teamwork.GetTotalTimeOps) (teamwork.ProjectTaskTotalTimes, error) {
+	mock.calls.record("GetTaskTotalTimeContext", ctx, id, ops)
+	if mock.GetTaskTotalTimeContextFunc == nil {
+		panic("teamworkmock: Client.GetTaskTotalTimeContextFunc is not set")
+	}
+	return mock.GetTaskTotalTimeContextFunc(ctx, id, ops)
+}
+
+// RateLimit calls RateLimitFunc.
+func (mock *Client) RateLimit() teamwork.RateLimit {
+	mock.calls.record("RateLimit")
+	if mock.RateLimitFunc == nil {
+		panic("teamworkmock: Client.RateLimitFunc is not set")
+	}
+	return mock.RateLimitFunc()
+}
@@ -0,0 +1,39 @@
+package teamworkmock_test
+
+import (
+	"fmt"
+
+	"github.com/swill/teamwork"
+	"github.com/swill/teamwork/teamworkmock"
+)
+
+// projectName is code under test which only needs the projects calls.
+func projectName(projects teamwork.ProjectsService, id string) string {
+	project, err := projects.GetProject(id, &teamwork.GetProjectOps{})
+	if err != nil {
+		return "unknown"
+	}
+	return project.Name
+}
+
+func ExampleClient() {
+	client := &teamworkmock.Client{
+		GetProjectFunc: func(id string, ops *teamwork.GetProjectOps) (teamwork.Project, error) {
+			if id != "158747" {
+				return teamwork.Project{}, &teamwork.APIError{StatusCode: 404}
+			}
+			return teamwork.Project{ID: teamwork.ID(id), Name: "Mobile App"}, nil
+		},
+	}
+
+	fmt.Println(projectName(client, "158747"))
+	fmt.Println(projectName(client, "1"))
+	for _, call := range client.CallsTo("GetProject") {
+		fmt.Println("GetProject called with", call.Args[0])
+	}
+	// Output:
+	// Mobile App
+	// unknown
+	// GetProject called with 158747
+	// GetProject called with 1
+}
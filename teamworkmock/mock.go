@@ -0,0 +1,55 @@
+// Package teamworkmock provides a mock of teamwork.Client for testing code
+// which depends on the teamwork package without calling TeamWork.
+//
+//	client := &teamworkmock.Client{
+//		GetProjectFunc: func(id string, ops *teamwork.GetProjectOps) (teamwork.Project, error) {
+//			return teamwork.Project{ID: teamwork.ID(id), Name: "Website"}, nil
+//		},
+//	}
+//
+// The Client is generated from the teamwork.Client interface, run
+// `go generate ./...` after changing it.
+package teamworkmock
+
+//go:generate go run ../internal/mockgen -src .. -iface Client -out client.go
+
+import "sync"
+
+// Call is a call made to a mock.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// recorder records the calls made to a mock.  It is safe for concurrent use.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// record records a call to the method.
+func (r *recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// all returns all the calls.
+func (r *recorder) all() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call{}, r.calls...)
+}
+
+// to returns the calls to the method.
+func (r *recorder) to(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calls := []Call{}
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
@@ -0,0 +1,222 @@
+package teamworktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Mode is whether a Recorder records or replays.
+type Mode int
+
+const (
+	// Replay serves the interactions stored in the golden file
+	// without making any network calls.
+	Replay Mode = iota
+	// Record sends the requests with the Transport of the Recorder and
+	// stores the interactions in the golden file.
+	Record
+)
+
+// Interaction is a request and the response it received.
+type Interaction struct {
+	Request struct {
+		Method string `json:"method"`
+		// The path and query of the request.
+		URI  string `json:"uri"`
+		Body string `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Header     http.Header `json:"header,omitempty"`
+		Body       string      `json:"body"`
+	} `json:"response"`
+}
+
+// Recorder is an http.RoundTripper which records the interactions with
+// TeamWork to a golden file, then replays them offline.  Use it as the
+// Transport of the http.Client passed to teamwork.WithHTTPClient.
+//
+// Requests are matched on their method, path, query and body, and each
+// recorded interaction is replayed once, in order.  The Authorization
+// header is never stored, and every key of Scrub is replaced by its value
+// in what is stored, so API tokens and host names can be kept out of the
+// golden file.  While recording, the caller gets the stored response, so
+// it sees the same thing whether the Recorder records or replays.
+type Recorder struct {
+	// Transport sends the requests when recording.
+	// Default: http.DefaultTransport
+	Transport http.RoundTripper
+	// Scrub replaces each key with its value in the stored interactions
+	// and in the responses returned while recording.
+	Scrub map[string]string
+
+	path string
+	mode Mode
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     []bool
+}
+
+// NewRecorder returns a Recorder for the golden file at path.
+// In Replay mode the golden file is loaded, in Record mode it
+// is replaced as the interactions are recorded.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		path: path,
+		mode: mode,
+	}
+	if mode == Replay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &r.interactions); err != nil {
+			return nil, fmt.Errorf("teamworktest: failed to load %s: %w", path, err)
+		}
+		r.replayed = make([]bool, len(r.interactions))
+	}
+	return r, nil
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == Record {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+// record sends the request, stores the interaction and returns the
+// stored response.
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &Interaction{}
+	interaction.Request.Method = req.Method
+	interaction.Request.URI = r.scrub(req.URL.RequestURI())
+	interaction.Request.Body = r.scrub(string(body))
+	interaction.Response.StatusCode = resp.StatusCode
+	interaction.Response.Header = http.Header{}
+	for name, values := range resp.Header {
+		if name == "Date" || name == "Set-Cookie" || name == "Content-Length" {
+			continue
+		}
+		for _, value := range values {
+			interaction.Response.Header.Add(name, r.scrub(value))
+		}
+	}
+	interaction.Response.Body = r.scrub(string(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, interaction)
+	if err := r.save(); err != nil {
+		return nil, err
+	}
+	return interaction.response(req), nil
+}
+
+// replay serves the first recorded interaction matching the request
+// which has not been replayed yet.
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.interactions {
+		if r.replayed[i] ||
+			interaction.Request.Method != req.Method ||
+			interaction.Request.URI != r.scrub(req.URL.RequestURI()) ||
+			interaction.Request.Body != r.scrub(string(body)) {
+			continue
+		}
+		r.replayed[i] = true
+		return interaction.response(req), nil
+	}
+	return nil, fmt.Errorf("teamworktest: no interaction recorded in %s for %s %s", r.path, req.Method, req.URL.RequestURI())
+}
+
+// response builds the recorded response to req.
+func (interaction *Interaction) response(req *http.Request) *http.Response {
+	header := interaction.Response.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}
+}
+
+// save writes the interactions to the golden file.  The caller must hold r.mu.
+func (r *Recorder) save() error {
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+// scrub replaces the keys of Scrub in s, longest first.
+func (r *Recorder) scrub(s string) string {
+	keys := make([]string, 0, len(r.Scrub))
+	for key := range r.Scrub {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return len(keys[i]) > len(keys[j]) })
+	for _, key := range keys {
+		s = strings.ReplaceAll(s, key, r.Scrub[key])
+	}
+	return s
+}
+
+// readBody reads the body of the request and returns a copy of the
+// request with the body put back.
+func readBody(req *http.Request) (*http.Request, []byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return req, body, nil
+}
@@ -0,0 +1,80 @@
+package teamworktest_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/swill/teamwork"
+	"github.com/swill/teamwork/teamworktest"
+)
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "projects.json")
+
+	// record against a fake TeamWork
+	server := teamworktest.NewServer()
+	server.AddProjects(teamwork.Project{ID: "1", Name: "Website"})
+	recorder, err := teamworktest.NewRecorder(path, teamworktest.Record)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	recorder.Scrub = map[string]string{server.Token: "REDACTED"}
+	conn, err := server.Connect(teamwork.WithHTTPClient(&http.Client{Transport: recorder}))
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	if _, _, err := conn.GetProjects(&teamwork.GetProjectsOps{Status: "ALL"}); err != nil {
+		t.Fatalf("GetProjects() error = %v", err)
+	}
+	server.Close()
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if strings.Contains(string(golden), server.Token) {
+		t.Errorf("golden file contains the API token:\n%s", golden)
+	}
+
+	// replay with the fake TeamWork gone
+	recorder, err = teamworktest.NewRecorder(path, teamworktest.Replay)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	conn, err = teamwork.Connect(server.URL, server.Token, teamwork.WithHTTPClient(&http.Client{Transport: recorder}))
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	projects, _, err := conn.GetProjects(&teamwork.GetProjectsOps{Status: "ALL"})
+	if err != nil {
+		t.Fatalf("GetProjects() error = %v", err)
+	}
+	if len(projects) != 1 || projects[0].Name != "Website" {
+		t.Errorf("GetProjects() = %+v, want the Website project", projects)
+	}
+
+	// every interaction is replayed once
+	if _, _, err := conn.GetProjects(&teamwork.GetProjectsOps{Status: "ALL"}); err == nil {
+		t.Errorf("GetProjects() replayed an interaction twice")
+	}
+}
+
+func TestRecorderScrubsResponses(t *testing.T) {
+	server := teamworktest.NewServer()
+	defer server.Close()
+	recorder, err := teamworktest.NewRecorder(filepath.Join(t.TempDir(), "connect.json"), teamworktest.Record)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	recorder.Scrub = map[string]string{server.URL: "https://example.teamwork.com"}
+	conn, err := server.Connect(teamwork.WithHTTPClient(&http.Client{Transport: recorder}))
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	if conn.Account.Url != "https://example.teamwork.com/" {
+		t.Errorf("Account.Url = %q, want the scrubbed https://example.teamwork.com/", conn.Account.Url)
+	}
+}
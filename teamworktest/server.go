@@ -0,0 +1,1848 @@
+// Package teamworktest provides an in-memory fake of the TeamWork API
+// for testing code built on a teamwork.Connection without a live account.
+//
+// The Server emulates the calls made by the teamwork package, serves the
+// data it is seeded with, paginates lists with the X-Page(s) headers and
+// can be told to fail requests.
+//
+//	server := teamworktest.NewServer()
+//	defer server.Close()
+//	server.AddProjects(teamwork.Project{ID: "1", Name: "Website"})
+//	conn, err := server.Connect()
+package teamworktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/swill/teamwork"
+)
+
+// Token is the API token accepted by a new Server.
+const Token = "teamworktest_apiToken"
+
+// Failure describes requests the Server should fail.
+type Failure struct {
+	// The method to fail (eg: "GET").  Empty fails every method.
+	Method string
+	// The path to fail (eg: "/projects.json").  Empty fails every path.
+	Path string
+	// The status code to respond with.
+	// Default: 500
+	StatusCode int
+	// The MESSAGE to respond with.
+	Message string
+	// Headers to respond with (eg: "Retry-After").
+	Header http.Header
+	// The number of requests to fail.  Zero fails every request.
+	Times int
+}
+
+// Server is an in-memory fake of the TeamWork API.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	// Token is the API token the Server accepts.  Empty accepts any token.
+	// Default: Token
+	Token string
+	// PageSize is the number of items on a page when the request does not
+	// specify a pageSize.
+	// Default: 100
+	PageSize int
+
+	mu          sync.Mutex
+	userID      string
+	projects    teamwork.Projects
+	people      teamwork.People
+	companies   teamwork.Companies
+	tasks       teamwork.Tasks
+	taskLists   teamwork.TaskLists
+	milestones  teamwork.Milestones
+	comments    []comment
+	timeEntries teamwork.TimeEntries
+	totalTime   teamwork.TotalTime
+	projectTime teamwork.ProjectTotalTimes
+	listTime    teamwork.ProjectTaskListTotalTimes
+	taskTime    teamwork.ProjectTaskTotalTimes
+	permissions map[string]teamwork.ProjectPermissions
+	failures    []*Failure
+	nextID      int
+}
+
+// NewServer starts a Server.  Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		Token:    Token,
+		PageSize: 100,
+		nextID:   1000,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Connect returns a teamwork.Connection to the Server.
+func (s *Server) Connect(opts ...teamwork.Option) (*teamwork.Connection, error) {
+	return teamwork.Connect(s.URL, s.Token, opts...)
+}
+
+// AddProjects seeds the Server with projects.
+func (s *Server) AddProjects(projects ...teamwork.Project) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.projects = append(s.projects, projects...)
+}
+
+// AddPeople seeds the Server with people.  The first person added is
+// the one returned by GetCurrentPerson, unless SetCurrentPerson is used.
+func (s *Server) AddPeople(people ...teamwork.Person) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.people = append(s.people, people...)
+}
+
+// SetCurrentPerson sets the ID of the person the API token belongs to.
+func (s *Server) SetCurrentPerson(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.userID = id
+}
+
+// AddCompanies seeds the Server with companies.
+func (s *Server) AddCompanies(companies ...teamwork.Company) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.companies = append(s.companies, companies...)
+}
+
+// AddTasks seeds the Server with tasks.
+func (s *Server) AddTasks(tasks ...teamwork.Task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks = append(s.tasks, tasks...)
+}
+
+// AddTaskLists seeds the Server with task lists.
+func (s *Server) AddTaskLists(taskLists ...teamwork.TaskList) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.taskLists = append(s.taskLists, taskLists...)
+}
+
+// AddMilestones seeds the Server with milestones.
+func (s *Server) AddMilestones(milestones ...teamwork.Milestone) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.milestones = append(s.milestones, milestones...)
+}
+
+// AddComments seeds the Server with comments on the resource with the ID,
+// eg: AddComments("tasks", "1", comments...).
+func (s *Server) AddComments(resourceType, id string, comments ...teamwork.Comment) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range comments {
+		s.comments = append(s.comments, comment{resourceType, id, c})
+	}
+}
+
+// Comments returns the comments the Server holds on the resource with the
+// ID, including the ones created through the API.
+func (s *Server) Comments(resourceType, id string) teamwork.Comments {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.resourceComments(resourceType, id)
+}
+
+// AddTimeEntries seeds the Server with time entries.
+func (s *Server) AddTimeEntries(timeEntries ...teamwork.TimeEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.timeEntries = append(s.timeEntries, timeEntries...)
+}
+
+// TimeEntries returns the time entries the Server holds, including the
+// ones created through the API.
+func (s *Server) TimeEntries() teamwork.TimeEntries {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append(teamwork.TimeEntries{}, s.timeEntries...)
+}
+
+// SetTotalTime seeds the total time over the whole account.
+func (s *Server) SetTotalTime(totalTime teamwork.TotalTime) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.totalTime = totalTime
+}
+
+// AddProjectTotalTimes seeds the total times of projects.
+func (s *Server) AddProjectTotalTimes(totals ...teamwork.ProjectTotalTime) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.projectTime = append(s.projectTime, totals...)
+}
+
+// AddTaskListTotalTimes seeds the total times of task lists.
+func (s *Server) AddTaskListTotalTimes(totals ...teamwork.ProjectTaskListTotalTime) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listTime = append(s.listTime, totals...)
+}
+
+// AddTaskTotalTimes seeds the total times of tasks.
+func (s *Server) AddTaskTotalTimes(totals ...teamwork.ProjectTaskTotalTime) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.taskTime = append(s.taskTime, totals...)
+}
+
+// Fail makes the Server fail the requests described by the Failure.
+// Failures are matched in the order they were added.
+func (s *Server) Fail(failure Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if failure.StatusCode == 0 {
+		failure.StatusCode = http.StatusInternalServerError
+	}
+	s.failures = append(s.failures, &failure)
+}
+
+// ClearFailures removes all the failures added with Fail.
+func (s *Server) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = nil
+}
+
+// route is an endpoint of the Server.  The path is matched without the
+// ".json" suffix and the matches are passed to the handler.
+type route struct {
+	method  string
+	path    *regexp.Regexp
+	handler func(s *Server, w http.ResponseWriter, r *http.Request, match []string)
+}
+
+var routes = []route{
+	{"GET", regexp.MustCompile(`^/authenticate$`), (*Server).authenticate},
+	{"GET", regexp.MustCompile(`^/projects$`), (*Server).getProjects},
+	{"GET", regexp.MustCompile(`^/projects/([^/]+)$`), (*Server).getProject},
+	{"POST", regexp.MustCompile(`^/projects$`), (*Server).createProject},
+	{"PUT", regexp.MustCompile(`^/projects/([^/]+)$`), (*Server).updateProject},
+	{"DELETE", regexp.MustCompile(`^/projects/([^/]+)$`), (*Server).deleteProject},
+	{"PUT", regexp.MustCompile(`^/projects/([^/]+)/star$`), (*Server).starProject},
+	{"PUT", regexp.MustCompile(`^/projects/([^/]+)/unstar$`), (*Server).unstarProject},
+	{"GET", regexp.MustCompile(`^/people$`), (*Server).getPeople},
+	{"GET", regexp.MustCompile(`^/projects/([^/]+)/people$`), (*Server).getProjectPeople},
+	{"PUT", regexp.MustCompile(`^/projects/([^/]+)/people$`), (*Server).updateProjectPeople},
+	{"PUT", regexp.MustCompile(`^/projects/([^/]+)/people/([^/]+)$`), (*Server).updateProjectPermissions},
+	{"DELETE", regexp.MustCompile(`^/projects/([^/]+)/people/([^/]+)$`), (*Server).removeProjectPerson},
+	{"GET", regexp.MustCompile(`^/companies/([^/]+)/people$`), (*Server).getCompanyPeople},
+	{"GET", regexp.MustCompile(`^/companies$`), (*Server).getCompanies},
+	{"GET", regexp.MustCompile(`^/companies/([^/]+)$`), (*Server).getCompany},
+	{"GET", regexp.MustCompile(`^/companies/([^/]+)/projects$`), (*Server).getCompanyProjects},
+	{"POST", regexp.MustCompile(`^/companies$`), (*Server).createCompany},
+	{"PUT", regexp.MustCompile(`^/companies/([^/]+)$`), (*Server).updateCompany},
+	{"DELETE", regexp.MustCompile(`^/companies/([^/]+)$`), (*Server).deleteCompany},
+	{"GET", regexp.MustCompile(`^/people/([^/]+)$`), (*Server).getPerson},
+	{"POST", regexp.MustCompile(`^/people$`), (*Server).createPerson},
+	{"PUT", regexp.MustCompile(`^/people/([^/]+)$`), (*Server).updatePerson},
+	{"DELETE", regexp.MustCompile(`^/people/([^/]+)$`), (*Server).deletePerson},
+	{"GET", regexp.MustCompile(`^/me$`), (*Server).getCurrentPerson},
+	{"GET", regexp.MustCompile(`^/tasks$`), (*Server).getTasks},
+	{"GET", regexp.MustCompile(`^/projects/([^/]+)/tasks$`), (*Server).getProjectTasks},
+	{"GET", regexp.MustCompile(`^/tasklists/([^/]+)/tasks$`), (*Server).getTaskListTasks},
+	{"POST", regexp.MustCompile(`^/tasklists/([^/]+)/tasks$`), (*Server).createTask},
+	{"POST", regexp.MustCompile(`^/tasks/([^/]+)$`), (*Server).createSubTask},
+	{"PUT", regexp.MustCompile(`^/tasks/([^/]+)$`), (*Server).updateTask},
+	{"PUT", regexp.MustCompile(`^/tasks/([^/]+)/complete$`), (*Server).completeTask},
+	{"PUT", regexp.MustCompile(`^/tasks/([^/]+)/uncomplete$`), (*Server).uncompleteTask},
+	{"DELETE", regexp.MustCompile(`^/tasks/([^/]+)$`), (*Server).deleteTask},
+	{"GET", regexp.MustCompile(`^/projects/([^/]+)/tasklists$`), (*Server).getProjectTaskLists},
+	{"POST", regexp.MustCompile(`^/projects/([^/]+)/tasklists$`), (*Server).createTaskList},
+	{"PUT", regexp.MustCompile(`^/projects/([^/]+)/tasklists/reorder$`), (*Server).reorderTaskLists},
+	{"GET", regexp.MustCompile(`^/tasklists/([^/]+)$`), (*Server).getTaskList},
+	{"PUT", regexp.MustCompile(`^/tasklists/([^/]+)$`), (*Server).updateTaskList},
+	{"DELETE", regexp.MustCompile(`^/tasklists/([^/]+)$`), (*Server).deleteTaskList},
+	{"PUT", regexp.MustCompile(`^/tasklists/([^/]+)/complete$`), (*Server).completeTaskList},
+	{"GET", regexp.MustCompile(`^/(tasks|milestones|files|notebooks|links)/([^/]+)/comments$`), (*Server).getComments},
+	{"POST", regexp.MustCompile(`^/(tasks|milestones|files|notebooks|links)/([^/]+)/comments$`), (*Server).createComment},
+	{"PUT", regexp.MustCompile(`^/comments/([^/]+)$`), (*Server).updateComment},
+	{"DELETE", regexp.MustCompile(`^/comments/([^/]+)$`), (*Server).deleteComment},
+	{"PUT", regexp.MustCompile(`^/comments/([^/]+)/markread$`), (*Server).markCommentRead},
+	{"GET", regexp.MustCompile(`^/milestones$`), (*Server).getMilestones},
+	{"GET", regexp.MustCompile(`^/projects/([^/]+)/milestones$`), (*Server).getProjectMilestones},
+	{"POST", regexp.MustCompile(`^/projects/([^/]+)/milestones$`), (*Server).createMilestone},
+	{"GET", regexp.MustCompile(`^/milestones/([^/]+)$`), (*Server).getMilestone},
+	{"PUT", regexp.MustCompile(`^/milestones/([^/]+)$`), (*Server).updateMilestone},
+	{"DELETE", regexp.MustCompile(`^/milestones/([^/]+)$`), (*Server).deleteMilestone},
+	{"PUT", regexp.MustCompile(`^/milestones/([^/]+)/complete$`), (*Server).completeMilestone},
+	{"PUT", regexp.MustCompile(`^/milestones/([^/]+)/uncomplete$`), (*Server).uncompleteMilestone},
+	{"GET", regexp.MustCompile(`^/time_entries$`), (*Server).getTimeEntries},
+	{"GET", regexp.MustCompile(`^/projects/([^/]+)/time_entries$`), (*Server).getProjectTimeEntries},
+	{"GET", regexp.MustCompile(`^/tasks/([^/]+)/time_entries$`), (*Server).getTaskTimeEntries},
+	{"POST", regexp.MustCompile(`^/projects/([^/]+)/time_entries$`), (*Server).createProjectTimeEntry},
+	{"POST", regexp.MustCompile(`^/tasks/([^/]+)/time_entries$`), (*Server).createTaskTimeEntry},
+	{"GET", regexp.MustCompile(`^/time_entries/([^/]+)$`), (*Server).getTimeEntry},
+	{"PUT", regexp.MustCompile(`^/time_entries/([^/]+)$`), (*Server).updateTimeEntry},
+	{"DELETE", regexp.MustCompile(`^/time_entries/([^/]+)$`), (*Server).deleteTimeEntry},
+	{"GET", regexp.MustCompile(`^/time/total$`), (*Server).getTotalTime},
+	{"GET", regexp.MustCompile(`^/projects/([^/]+)/time/total$`), (*Server).getProjectTotalTime},
+	{"GET", regexp.MustCompile(`^/tasklists/([^/]+)/time/total$`), (*Server).getTaskListTotalTime},
+	{"GET", regexp.MustCompile(`^/tasks/([^/]+)/time/total$`), (*Server).getTaskTotalTime},
+}
+
+// serveHTTP authenticates, applies the failures and routes a request.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "Invalid API token", nil)
+		return
+	}
+	if failure := s.failure(r); failure != nil {
+		writeError(w, failure.StatusCode, failure.Message, failure.Header)
+		return
+	}
+
+	path := strings.TrimSuffix(r.URL.Path, ".json")
+	for _, route := range routes {
+		if match := route.path.FindStringSubmatch(path); match != nil && route.method == r.Method {
+			route.handler(s, w, r, match)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, fmt.Sprintf("No route for %s %s", r.Method, r.URL.Path), nil)
+}
+
+// authorized reports whether the request carries the API token.
+func (s *Server) authorized(r *http.Request) bool {
+	if s.Token == "" {
+		return true
+	}
+	if token, _, ok := r.BasicAuth(); ok {
+		return token == s.Token
+	}
+	return r.Header.Get("Authorization") == "Bearer "+s.Token
+}
+
+// failure returns the Failure matching the request, if any.
+func (s *Server) failure(r *http.Request) *Failure {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, failure := range s.failures {
+		if failure.Method != "" && failure.Method != r.Method {
+			continue
+		}
+		if failure.Path != "" && failure.Path != r.URL.Path {
+			continue
+		}
+		if failure.Times > 0 {
+			failure.Times--
+			if failure.Times == 0 {
+				s.failures = append(s.failures[:i:i], s.failures[i+1:]...)
+			}
+		}
+		return failure
+	}
+	return nil
+}
+
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.mu.Lock()
+	userID := s.currentUserID()
+	companyID := ""
+	for _, person := range s.people {
+		if id(person.ID) == userID {
+			companyID = id(person.CompanyID)
+		}
+	}
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"account": map[string]interface{}{
+			"URL":       s.URL + "/",
+			"userId":    userID,
+			"companyid": companyID,
+			"id":        "1",
+			"name":      "teamworktest",
+		},
+	})
+}
+
+func (s *Server) getProjects(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.mu.Lock()
+	projects := filter(s.projects, func(p teamwork.Project) bool { return true })
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "projects", projects)
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	projects := filter(s.projects, func(p teamwork.Project) bool { return id(p.ID) == match[1] })
+	s.mu.Unlock()
+	writeOne(w, "project", "Project", projects)
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request, _ []string) {
+	body := struct {
+		Project teamwork.CreateProjectOps `json:"project"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	ops := body.Project
+	if ops.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Project name is required", nil)
+		return
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	project := teamwork.Project{
+		ID:             teamwork.ID(strconv.Itoa(s.nextID)),
+		Name:           ops.Name,
+		Description:    ops.Description,
+		StartDate:      date(ops.StartDate),
+		EndDate:        date(ops.EndDate),
+		DefaultPrivacy: ops.DefaultPrivacy,
+		Announcement:   ops.Announcement,
+		Status:         "active",
+	}
+	project.Category.ID = teamwork.ID(ops.CategoryID)
+	project.Company.ID = teamwork.ID(ops.CompanyID)
+	s.projects = append(s.projects, project)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"id": id(project.ID)})
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		Project teamwork.UpdateProjectOps `json:"project"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	ops := body.Project
+	s.updateProjects(w, match[1], func(p *teamwork.Project) {
+		p.Name = or(ops.Name, p.Name)
+		p.Description = or(ops.Description, p.Description)
+		p.Status = or(ops.Status, p.Status)
+		p.DefaultPrivacy = or(ops.DefaultPrivacy, p.DefaultPrivacy)
+		p.Announcement = or(ops.Announcement, p.Announcement)
+		p.Category.ID = or(teamwork.ID(ops.CategoryID), p.Category.ID)
+		p.Company.ID = or(teamwork.ID(ops.CompanyID), p.Company.ID)
+		if ops.StartDate != "" {
+			p.StartDate = date(ops.StartDate)
+		}
+		if ops.EndDate != "" {
+			p.EndDate = date(ops.EndDate)
+		}
+	})
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	before := len(s.projects)
+	s.projects = filter(s.projects, func(p teamwork.Project) bool { return id(p.ID) != match[1] })
+	deleted := len(s.projects) < before
+	s.mu.Unlock()
+	if !deleted {
+		writeError(w, http.StatusNotFound, "Project not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) starProject(w http.ResponseWriter, r *http.Request, match []string) {
+	s.updateProjects(w, match[1], func(p *teamwork.Project) { p.Starred = true })
+}
+
+func (s *Server) unstarProject(w http.ResponseWriter, r *http.Request, match []string) {
+	s.updateProjects(w, match[1], func(p *teamwork.Project) { p.Starred = false })
+}
+
+// updateProjects applies update to the project with the ID and responds.
+func (s *Server) updateProjects(w http.ResponseWriter, projectID string, update func(*teamwork.Project)) {
+	s.mu.Lock()
+	found := false
+	for i := range s.projects {
+		if id(s.projects[i].ID) == projectID {
+			update(&s.projects[i])
+			found = true
+		}
+	}
+	s.mu.Unlock()
+	if !found {
+		writeError(w, http.StatusNotFound, "Project not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) getPeople(w http.ResponseWriter, r *http.Request, _ []string) {
+	email := r.URL.Query().Get("emailaddress")
+	s.mu.Lock()
+	people := filter(s.people, func(p teamwork.Person) bool { return email == "" || p.EmailAddress == email })
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "people", people)
+}
+
+func (s *Server) getProjectPeople(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	people := filter(s.people, func(p teamwork.Person) bool {
+		for _, projectID := range p.Projects {
+			if id(projectID) == match[1] {
+				return true
+			}
+		}
+		return false
+	})
+	for i := range people {
+		if perms, ok := s.permissions[match[1]+"/"+id(people[i].ID)]; ok {
+			people[i].Permissions.ProjectPermissions = perms
+		}
+	}
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "people", people)
+}
+
+func (s *Server) updateProjectPeople(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		Add, Remove struct {
+			UserIDList string `json:"userIdList"`
+		}
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(filter(s.projects, func(p teamwork.Project) bool { return id(p.ID) == match[1] })) == 0 {
+		writeError(w, http.StatusNotFound, "Project not found", nil)
+		return
+	}
+	for _, personID := range strings.Split(body.Add.UserIDList, ",") {
+		if personID != "" && !s.setProjectPerson(match[1], personID, true) {
+			writeError(w, http.StatusNotFound, "Person not found", nil)
+			return
+		}
+	}
+	for _, personID := range strings.Split(body.Remove.UserIDList, ",") {
+		if personID != "" {
+			s.setProjectPerson(match[1], personID, false)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) removeProjectPerson(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	removed := s.setProjectPerson(match[1], match[2], false)
+	s.mu.Unlock()
+	if !removed {
+		writeError(w, http.StatusNotFound, "Person not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) updateProjectPermissions(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		Permissions map[string]interface{} `json:"permissions"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := match[1] + "/" + match[2]
+	perms, ok := s.permissions[key]
+	if !ok {
+		people := filter(s.people, func(p teamwork.Person) bool {
+			for _, projectID := range p.Projects {
+				if id(projectID) == match[1] {
+					return id(p.ID) == match[2]
+				}
+			}
+			return false
+		})
+		if len(people) == 0 {
+			writeError(w, http.StatusNotFound, "Person not found on project", nil)
+			return
+		}
+		perms = people[0].Permissions.ProjectPermissions
+	}
+	if err := patch(&perms, body.Permissions); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if s.permissions == nil {
+		s.permissions = map[string]teamwork.ProjectPermissions{}
+	}
+	s.permissions[key] = perms
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// setProjectPerson adds a person to, or removes them from, a project,
+// keeping Person.Projects and Project.People in step.  It reports whether
+// the person exists and, when removing, was on the project.
+func (s *Server) setProjectPerson(projectID, personID string, add bool) bool {
+	found := false
+	for i := range s.people {
+		person := &s.people[i]
+		if id(person.ID) != personID {
+			continue
+		}
+		projects := filter(person.Projects, func(p teamwork.ID) bool { return id(p) != projectID })
+		found = add || len(projects) < len(person.Projects)
+		if add {
+			projects = append(projects, teamwork.ID(projectID))
+		}
+		person.Projects = projects
+	}
+	for i := range s.projects {
+		project := &s.projects[i]
+		if id(project.ID) != projectID {
+			continue
+		}
+		project.People = filter(project.People, func(p teamwork.ID) bool { return id(p) != personID })
+		if add && found {
+			project.People = append(project.People, teamwork.ID(personID))
+		}
+	}
+	if !add {
+		delete(s.permissions, projectID+"/"+personID)
+	}
+	return found
+}
+
+func (s *Server) getCompanyPeople(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	people := filter(s.people, func(p teamwork.Person) bool { return id(p.CompanyID) == match[1] })
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "people", people)
+}
+
+func (s *Server) getPerson(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	people := filter(s.people, func(p teamwork.Person) bool { return id(p.ID) == match[1] })
+	s.mu.Unlock()
+	writeOne(w, "person", "Person", people)
+}
+
+func (s *Server) createPerson(w http.ResponseWriter, r *http.Request, _ []string) {
+	fields, err := personFields(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	email, _ := fields["email-address"].(string)
+	if email == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Email address is required", nil)
+		return
+	}
+	if invite, _ := fields["sendWelcomeEmail"].(bool); invite {
+		fields["user-invited"] = true
+		fields["user-invited-status"] = "PENDING"
+	}
+	if fields["user-name"] == nil {
+		fields["user-name"] = email
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(filter(s.people, func(p teamwork.Person) bool { return strings.EqualFold(p.EmailAddress, email) })) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "A person with this email address already exists", nil)
+		return
+	}
+	s.nextID++
+	fields["id"] = s.nextID
+	person := teamwork.Person{}
+	if err := patch(&person, fields); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	s.people = append(s.people, person)
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"id": id(person.ID)})
+}
+
+func (s *Server) updatePerson(w http.ResponseWriter, r *http.Request, match []string) {
+	fields, err := personFields(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	found := false
+	for i := range s.people {
+		if id(s.people[i].ID) == match[1] {
+			err = patch(&s.people[i], fields)
+			found = true
+		}
+	}
+	s.mu.Unlock()
+	if !found {
+		writeError(w, http.StatusNotFound, "Person not found", nil)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) deletePerson(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	before := len(s.people)
+	s.people = filter(s.people, func(p teamwork.Person) bool { return id(p.ID) != match[1] })
+	deleted := len(s.people) < before
+	s.mu.Unlock()
+	if !deleted {
+		writeError(w, http.StatusNotFound, "Person not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) getCompanies(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.mu.Lock()
+	companies := append(teamwork.Companies{}, s.companies...)
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "companies", companies)
+}
+
+func (s *Server) getCompany(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	companies := filter(s.companies, func(c teamwork.Company) bool { return id(c.ID) == match[1] })
+	s.mu.Unlock()
+	writeOne(w, "company", "Company", companies)
+}
+
+func (s *Server) getCompanyProjects(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	projects := filter(s.projects, func(p teamwork.Project) bool { return id(p.Company.ID) == match[1] })
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "projects", projects)
+}
+
+func (s *Server) createCompany(w http.ResponseWriter, r *http.Request, _ []string) {
+	body := struct {
+		Company teamwork.CreateCompanyOps `json:"company"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if body.Company.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Company name is required", nil)
+		return
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	company := teamwork.Company{ID: teamwork.ID(strconv.Itoa(s.nextID))}
+	updateCompany(&company, teamwork.UpdateCompanyOps(body.Company))
+	s.companies = append(s.companies, company)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"id": id(company.ID)})
+}
+
+func (s *Server) updateCompany(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		Company teamwork.UpdateCompanyOps `json:"company"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	found := false
+	for i := range s.companies {
+		if id(s.companies[i].ID) == match[1] {
+			updateCompany(&s.companies[i], body.Company)
+			found = true
+		}
+	}
+	s.mu.Unlock()
+	if !found {
+		writeError(w, http.StatusNotFound, "Company not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) deleteCompany(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	before := len(s.companies)
+	s.companies = filter(s.companies, func(c teamwork.Company) bool { return id(c.ID) != match[1] })
+	deleted := len(s.companies) < before
+	s.mu.Unlock()
+	if !deleted {
+		writeError(w, http.StatusNotFound, "Company not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// updateCompany sets the fields of the company which are set in ops.
+func updateCompany(c *teamwork.Company, ops teamwork.UpdateCompanyOps) {
+	c.Name = or(ops.Name, c.Name)
+	c.AddressOne = or(ops.AddressOne, c.AddressOne)
+	c.AddressTwo = or(ops.AddressTwo, c.AddressTwo)
+	c.City = or(ops.City, c.City)
+	c.State = or(ops.State, c.State)
+	c.Zip = or(ops.Zip, c.Zip)
+	c.CountryCode = or(ops.CountryCode, c.CountryCode)
+	c.Phone = or(ops.Phone, c.Phone)
+	c.Fax = or(ops.Fax, c.Fax)
+	c.Website = or(ops.Website, c.Website)
+	c.EmailOne = or(ops.EmailOne, c.EmailOne)
+	c.EmailTwo = or(ops.EmailTwo, c.EmailTwo)
+	c.EmailThree = or(ops.EmailThree, c.EmailThree)
+	c.IndustryID = or(teamwork.ID(ops.IndustryID), c.IndustryID)
+	c.PrivateNotes = or(ops.PrivateNotes, c.PrivateNotes)
+	c.ProfileText = or(ops.ProfileText, c.ProfileText)
+}
+
+// personFields decodes a posted person into the fields of a teamwork.Person.
+// The password is dropped.
+func personFields(r *http.Request) (map[string]interface{}, error) {
+	body := struct {
+		Person map[string]interface{} `json:"person"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	fields := body.Person
+	if fields == nil {
+		fields = map[string]interface{}{}
+	}
+	delete(fields, "password")
+	if companyID, ok := fields["company-id"]; ok {
+		fields["companyId"] = companyID
+		delete(fields, "company-id")
+	}
+	localization := map[string]interface{}{}
+	for name, key := range map[string]string{
+		"userLanguage":    "languageCode",
+		"dateFormatId":    "dateFormatId",
+		"timeFormatId":    "timeFormatId",
+		"timezoneId":      "timezoneId",
+		"start-on-sunday": "start-on-sunday",
+	} {
+		if value, ok := fields[name]; ok {
+			localization[key] = value
+			delete(fields, name)
+		}
+	}
+	if len(localization) > 0 {
+		fields["localization"] = localization
+	}
+	return fields, nil
+}
+
+func (s *Server) getCurrentPerson(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.mu.Lock()
+	userID := s.currentUserID()
+	people := filter(s.people, func(p teamwork.Person) bool { return id(p.ID) == userID })
+	s.mu.Unlock()
+	writeOne(w, "person", "Person", people)
+}
+
+// currentUserID is the ID of the person the API token belongs to.
+// The caller must hold s.mu.
+func (s *Server) currentUserID() string {
+	if s.userID == "" && len(s.people) > 0 {
+		return id(s.people[0].ID)
+	}
+	return s.userID
+}
+
+func (s *Server) getTasks(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.mu.Lock()
+	tasks := filter(s.tasks, func(t teamwork.Task) bool { return true })
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "todo-items", tasks)
+}
+
+func (s *Server) getProjectTasks(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	tasks := filter(s.tasks, func(t teamwork.Task) bool { return id(t.ProjectID) == match[1] })
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "todo-items", tasks)
+}
+
+func (s *Server) getTaskListTasks(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	tasks := filter(s.tasks, func(t teamwork.Task) bool { return id(t.TaskListID) == match[1] })
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "todo-items", tasks)
+}
+
+func (s *Server) createTask(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	taskLists := filter(s.taskLists, func(t teamwork.TaskList) bool { return id(t.ID) == match[1] })
+	s.mu.Unlock()
+	if len(taskLists) == 0 {
+		writeError(w, http.StatusNotFound, "Task list not found", nil)
+		return
+	}
+	s.addTask(w, r, map[string]interface{}{
+		"todo-list-id":   json.Number(id(taskLists[0].ID)),
+		"todo-list-name": taskLists[0].Name,
+		"project-id":     json.Number(id(taskLists[0].ProjectID)),
+		"project-name":   taskLists[0].ProjectName,
+	})
+}
+
+func (s *Server) createSubTask(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	parents := filter(s.tasks, func(t teamwork.Task) bool { return id(t.ID) == match[1] })
+	s.mu.Unlock()
+	if len(parents) == 0 {
+		writeError(w, http.StatusNotFound, "Task not found", nil)
+		return
+	}
+	s.addTask(w, r, map[string]interface{}{
+		"parentTaskId":   id(parents[0].ID),
+		"todo-list-id":   json.Number(id(parents[0].TaskListID)),
+		"todo-list-name": parents[0].TaskListName,
+		"project-id":     json.Number(id(parents[0].ProjectID)),
+		"project-name":   parents[0].ProjectName,
+	})
+}
+
+// addTask stores a task posted for a task list or a parent task, with the
+// fields of the task it belongs to.
+func (s *Server) addTask(w http.ResponseWriter, r *http.Request, fields map[string]interface{}) {
+	body := struct {
+		Task teamwork.CreateTaskOps `json:"todo-item"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	ops := body.Task
+	if ops.Content == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Task content is required", nil)
+		return
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	fields["id"] = s.nextID
+	fields["status"] = "new"
+	for name, value := range taskFields(mustJSON(ops)) {
+		fields[name] = value
+	}
+	task := teamwork.Task{}
+	err := patch(&task, fields)
+	if err == nil {
+		s.tasks = append(s.tasks, task)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"id": id(task.ID)})
+}
+
+func (s *Server) updateTask(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		Task json.RawMessage `json:"todo-item"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	fields := taskFields(body.Task)
+	if taskListID, ok := fields["tasklistId"]; ok {
+		delete(fields, "tasklistId")
+		s.mu.Lock()
+		taskLists := filter(s.taskLists, func(t teamwork.TaskList) bool { return id(t.ID) == id(taskListID) })
+		s.mu.Unlock()
+		if len(taskLists) == 0 {
+			writeError(w, http.StatusNotFound, "Task list not found", nil)
+			return
+		}
+		fields["todo-list-id"] = json.Number(id(taskLists[0].ID))
+		fields["todo-list-name"] = taskLists[0].Name
+	}
+	s.updateTasks(w, match[1], fields)
+}
+
+func (s *Server) completeTask(w http.ResponseWriter, r *http.Request, match []string) {
+	s.updateTasks(w, match[1], map[string]interface{}{"completed": true, "status": "completed"})
+}
+
+func (s *Server) uncompleteTask(w http.ResponseWriter, r *http.Request, match []string) {
+	s.updateTasks(w, match[1], map[string]interface{}{"completed": false, "status": "reopened"})
+}
+
+// updateTasks patches the task with the ID with fields and responds.
+func (s *Server) updateTasks(w http.ResponseWriter, taskID string, fields map[string]interface{}) {
+	s.mu.Lock()
+	found := false
+	var err error
+	for i := range s.tasks {
+		if id(s.tasks[i].ID) == taskID {
+			err = patch(&s.tasks[i], fields)
+			found = true
+		}
+	}
+	s.mu.Unlock()
+	if !found {
+		writeError(w, http.StatusNotFound, "Task not found", nil)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) deleteTask(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	before := len(s.tasks)
+	s.tasks = filter(s.tasks, func(t teamwork.Task) bool { return id(t.ID) != match[1] })
+	deleted := len(s.tasks) < before
+	s.mu.Unlock()
+	if !deleted {
+		writeError(w, http.StatusNotFound, "Task not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// taskFields decodes the fields of a posted todo-item which are stored on
+// the task as they are.  Tags and the private and notify flags are dropped.
+func taskFields(data []byte) map[string]interface{} {
+	fields := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	decoder.Decode(&fields)
+	delete(fields, "tags")
+	delete(fields, "private")
+	delete(fields, "notify")
+	return fields
+}
+
+func (s *Server) getProjectTaskLists(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	taskLists := filter(s.taskLists, func(t teamwork.TaskList) bool { return id(t.ProjectID) == match[1] })
+	if show := r.URL.Query().Get("showMilestones"); show == "1" || show == "true" || show == "yes" {
+		for i := range taskLists {
+			milestones := filter(s.milestones, func(m teamwork.Milestone) bool { return m.ID == taskLists[i].MilestoneID })
+			if len(milestones) > 0 {
+				taskLists[i].Milestone = &milestones[0]
+			}
+		}
+	}
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "tasklists", taskLists)
+}
+
+func (s *Server) getTaskList(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	taskLists := filter(s.taskLists, func(t teamwork.TaskList) bool { return id(t.ID) == match[1] })
+	s.mu.Unlock()
+	writeOne(w, "todo-list", "Task list", taskLists)
+}
+
+// createTaskList stores a task list posted for a project.  When it is
+// created from a template, the tasks of the template are copied to it and
+// start on the template start date.
+func (s *Server) createTaskList(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		TaskList json.RawMessage `json:"todo-list"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	ops := teamwork.CreateTaskListOps{}
+	templateOps := teamwork.CreateTaskListFromTemplateOps{}
+	if err := json.Unmarshal(body.TaskList, &ops); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if err := json.Unmarshal(body.TaskList, &templateOps); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	projects := filter(s.projects, func(p teamwork.Project) bool { return id(p.ID) == match[1] })
+	if len(projects) == 0 {
+		writeError(w, http.StatusNotFound, "Project not found", nil)
+		return
+	}
+	var template *teamwork.TaskList
+	if templateOps.TemplateID != "" {
+		templates := filter(s.taskLists, func(t teamwork.TaskList) bool { return id(t.ID) == templateOps.TemplateID && bool(t.IsTemplate) })
+		if len(templates) == 0 {
+			writeError(w, http.StatusNotFound, "Task list template not found", nil)
+			return
+		}
+		template = &templates[0]
+	}
+	name := ops.Name
+	if name == "" && template != nil {
+		name = template.Name
+	}
+	if name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Task list name is required", nil)
+		return
+	}
+
+	s.nextID++
+	taskList := teamwork.TaskList{}
+	err := patch(&taskList, map[string]interface{}{
+		"id":           strconv.Itoa(s.nextID),
+		"name":         name,
+		"description":  ops.Description,
+		"milestone-id": ops.MilestoneID,
+		"projectId":    id(projects[0].ID),
+		"projectName":  projects[0].Name,
+		"status":       "new",
+		"position":     len(filter(s.taskLists, func(t teamwork.TaskList) bool { return id(t.ProjectID) == match[1] })),
+	})
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	s.taskLists = append(s.taskLists, taskList)
+
+	if template != nil {
+		for _, task := range filter(s.tasks, func(t teamwork.Task) bool { return id(t.TaskListID) == id(template.ID) }) {
+			s.nextID++
+			if err := patch(&task, map[string]interface{}{
+				"id":             s.nextID,
+				"todo-list-id":   json.Number(id(taskList.ID)),
+				"todo-list-name": taskList.Name,
+				"project-id":     json.Number(id(taskList.ProjectID)),
+				"project-name":   taskList.ProjectName,
+				"start-date":     templateOps.StartDate,
+			}); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error(), nil)
+				return
+			}
+			s.tasks = append(s.tasks, task)
+		}
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"TASKLISTID": id(taskList.ID)})
+}
+
+func (s *Server) updateTaskList(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		TaskList json.RawMessage `json:"todo-list"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(body.TaskList, &fields); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	s.updateTaskLists(w, match[1], fields)
+}
+
+func (s *Server) completeTaskList(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	for i := range s.tasks {
+		if id(s.tasks[i].TaskListID) == match[1] {
+			patch(&s.tasks[i], map[string]interface{}{"completed": true, "status": "completed"})
+		}
+	}
+	s.mu.Unlock()
+	s.updateTaskLists(w, match[1], map[string]interface{}{"complete": true, "uncompleted-count": 0})
+}
+
+// updateTaskLists patches the task list with the ID with fields and responds.
+func (s *Server) updateTaskLists(w http.ResponseWriter, taskListID string, fields map[string]interface{}) {
+	s.mu.Lock()
+	found := false
+	var err error
+	for i := range s.taskLists {
+		if id(s.taskLists[i].ID) == taskListID {
+			err = patch(&s.taskLists[i], fields)
+			found = true
+		}
+	}
+	s.mu.Unlock()
+	if !found {
+		writeError(w, http.StatusNotFound, "Task list not found", nil)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) deleteTaskList(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	before := len(s.taskLists)
+	s.taskLists = filter(s.taskLists, func(t teamwork.TaskList) bool { return id(t.ID) != match[1] })
+	deleted := len(s.taskLists) < before
+	if deleted {
+		s.tasks = filter(s.tasks, func(t teamwork.Task) bool { return id(t.TaskListID) != match[1] })
+	}
+	s.mu.Unlock()
+	if !deleted {
+		writeError(w, http.StatusNotFound, "Task list not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) getMilestones(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.writeMilestones(w, r, func(teamwork.Milestone) bool { return true })
+}
+
+func (s *Server) getProjectMilestones(w http.ResponseWriter, r *http.Request, match []string) {
+	s.writeMilestones(w, r, func(m teamwork.Milestone) bool { return id(m.ProjectID) == match[1] })
+}
+
+// writeMilestones writes the milestones which match and the find query param.
+func (s *Server) writeMilestones(w http.ResponseWriter, r *http.Request, match func(teamwork.Milestone) bool) {
+	find := r.URL.Query().Get("find")
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	s.mu.Lock()
+	milestones := filter(s.milestones, func(m teamwork.Milestone) bool {
+		late := !bool(m.Completed) && m.Deadline.Before(today)
+		switch find {
+		case "completed":
+			return match(m) && bool(m.Completed)
+		case "incomplete":
+			return match(m) && !bool(m.Completed)
+		case "late":
+			return match(m) && late
+		case "upcoming":
+			return match(m) && !bool(m.Completed) && !late
+		}
+		return match(m)
+	})
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "milestones", milestones)
+}
+
+func (s *Server) getMilestone(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	milestones := filter(s.milestones, func(m teamwork.Milestone) bool { return id(m.ID) == match[1] })
+	s.mu.Unlock()
+	writeOne(w, "milestone", "Milestone", milestones)
+}
+
+// createMilestone stores a milestone posted for a project and attaches the
+// task lists in its tasklistIds.
+func (s *Server) createMilestone(w http.ResponseWriter, r *http.Request, match []string) {
+	fields, err := milestoneFields(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if title, _ := fields["title"].(string); title == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Milestone title is required", nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	projects := filter(s.projects, func(p teamwork.Project) bool { return id(p.ID) == match[1] })
+	if len(projects) == 0 {
+		writeError(w, http.StatusNotFound, "Project not found", nil)
+		return
+	}
+	s.nextID++
+	milestone := teamwork.Milestone{
+		ID:          teamwork.ID(strconv.Itoa(s.nextID)),
+		ProjectID:   projects[0].ID,
+		ProjectName: projects[0].Name,
+		Status:      "upcoming",
+	}
+	if err := patch(&milestone, fields); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	s.milestones = append(s.milestones, milestone)
+	s.attachTaskLists(milestone.ID, fields["tasklistIds"])
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"milestoneId": id(milestone.ID)})
+}
+
+func (s *Server) updateMilestone(w http.ResponseWriter, r *http.Request, match []string) {
+	fields, err := milestoneFields(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	s.updateMilestones(w, match[1], fields)
+}
+
+func (s *Server) completeMilestone(w http.ResponseWriter, r *http.Request, match []string) {
+	s.updateMilestones(w, match[1], map[string]interface{}{"completed": true, "status": "completed"})
+}
+
+func (s *Server) uncompleteMilestone(w http.ResponseWriter, r *http.Request, match []string) {
+	s.updateMilestones(w, match[1], map[string]interface{}{"completed": false, "status": "upcoming"})
+}
+
+// updateMilestones patches the milestone with the ID with fields and responds.
+func (s *Server) updateMilestones(w http.ResponseWriter, milestoneID string, fields map[string]interface{}) {
+	s.mu.Lock()
+	found := false
+	var err error
+	for i := range s.milestones {
+		if id(s.milestones[i].ID) == milestoneID {
+			err = patch(&s.milestones[i], fields)
+			found = true
+		}
+	}
+	if found {
+		s.attachTaskLists(teamwork.ID(milestoneID), fields["tasklistIds"])
+	}
+	s.mu.Unlock()
+	if !found {
+		writeError(w, http.StatusNotFound, "Milestone not found", nil)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) deleteMilestone(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	before := len(s.milestones)
+	s.milestones = filter(s.milestones, func(m teamwork.Milestone) bool { return id(m.ID) != match[1] })
+	deleted := len(s.milestones) < before
+	if deleted {
+		for i := range s.taskLists {
+			if id(s.taskLists[i].MilestoneID) == match[1] {
+				s.taskLists[i].MilestoneID = ""
+			}
+		}
+	}
+	s.mu.Unlock()
+	if !deleted {
+		writeError(w, http.StatusNotFound, "Milestone not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// attachTaskLists sets the milestone of the task lists in the comma
+// separated taskListIDs, when there are some.
+func (s *Server) attachTaskLists(milestoneID teamwork.ID, taskListIDs interface{}) {
+	ids, _ := taskListIDs.(string)
+	for _, taskListID := range strings.Split(ids, ",") {
+		for i := range s.taskLists {
+			if taskListID != "" && id(s.taskLists[i].ID) == strings.TrimSpace(taskListID) {
+				s.taskLists[i].MilestoneID = milestoneID
+			}
+		}
+	}
+}
+
+// milestoneFields decodes a posted milestone into the fields of a
+// teamwork.Milestone.
+func milestoneFields(r *http.Request) (map[string]interface{}, error) {
+	body := struct {
+		Milestone map[string]interface{} `json:"milestone"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Milestone == nil {
+		return map[string]interface{}{}, nil
+	}
+	return body.Milestone, nil
+}
+
+// comment is a comment with the resource it is on.
+type comment struct {
+	resourceType string
+	itemID       string
+	teamwork.Comment
+}
+
+// resourceComments returns the comments on the resource with the ID.
+func (s *Server) resourceComments(resourceType, itemID string) teamwork.Comments {
+	comments := teamwork.Comments{}
+	for _, c := range s.comments {
+		if c.resourceType == resourceType && c.itemID == itemID {
+			comments = append(comments, c.Comment)
+		}
+	}
+	return comments
+}
+
+func (s *Server) getComments(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	comments := s.resourceComments(match[1], match[2])
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "comments", comments)
+}
+
+// createComment stores a comment posted on a resource by the current
+// person.  Comments on tasks and milestones need the item to exist, and
+// comments on tasks are counted in its comments-count.
+func (s *Server) createComment(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		Comment teamwork.CreateCommentOps `json:"comment"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	ops := body.Comment
+	if ops.Body == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Comment body is required", nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	notFound := ""
+	switch match[1] {
+	case "tasks":
+		notFound = "Task not found"
+		for i := range s.tasks {
+			if id(s.tasks[i].ID) == match[2] {
+				s.tasks[i].CommentsCount++
+				notFound = ""
+			}
+		}
+	case "milestones":
+		if len(filter(s.milestones, func(m teamwork.Milestone) bool { return id(m.ID) == match[2] })) == 0 {
+			notFound = "Milestone not found"
+		}
+	}
+	if notFound != "" {
+		writeError(w, http.StatusNotFound, notFound, nil)
+		return
+	}
+
+	s.nextID++
+	c := teamwork.Comment{
+		ID:          teamwork.ID(strconv.Itoa(s.nextID)),
+		AuthorID:    teamwork.ID(s.currentUserID()),
+		CommentNo:   teamwork.FlexInt(len(s.resourceComments(match[1], match[2])) + 1),
+		DateTime:    teamwork.Time{Time: time.Now().UTC().Truncate(time.Second)},
+		HasRead:     true,
+		Notified:    ops.Notify,
+		Private:     teamwork.FlexBool(ops.IsPrivate != nil && *ops.IsPrivate),
+		ContentType: or(ops.ContentType, "TEXT"),
+	}
+	c.LastChangedOn = c.DateTime
+	setCommentBody(&c, ops.Body, ops.PendingFileAttachments)
+	for _, person := range s.people {
+		if person.ID == c.AuthorID {
+			c.AuthorFirstname = person.FirstName
+			c.AuthorLastname = person.LastName
+		}
+	}
+	s.comments = append(s.comments, comment{match[1], match[2], c})
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"commentId": id(c.ID)})
+}
+
+func (s *Server) updateComment(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		Comment teamwork.UpdateCommentOps `json:"comment"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	ops := body.Comment
+	s.updateComments(w, match[1], func(c *teamwork.Comment) {
+		c.ContentType = or(ops.ContentType, c.ContentType)
+		c.Notified = or(ops.Notify, c.Notified)
+		if ops.IsPrivate != nil {
+			c.Private = teamwork.FlexBool(*ops.IsPrivate)
+		}
+		setCommentBody(c, or(ops.Body, c.Body), ops.PendingFileAttachments)
+		c.LastChangedOn = teamwork.Time{Time: time.Now().UTC().Truncate(time.Second)}
+	})
+}
+
+func (s *Server) markCommentRead(w http.ResponseWriter, r *http.Request, match []string) {
+	s.updateComments(w, match[1], func(c *teamwork.Comment) { c.HasRead = true })
+}
+
+// updateComments applies update to the comment with the ID and responds.
+func (s *Server) updateComments(w http.ResponseWriter, commentID string, update func(*teamwork.Comment)) {
+	s.mu.Lock()
+	found := false
+	for i := range s.comments {
+		if id(s.comments[i].ID) == commentID {
+			update(&s.comments[i].Comment)
+			found = true
+		}
+	}
+	s.mu.Unlock()
+	if !found {
+		writeError(w, http.StatusNotFound, "Comment not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) deleteComment(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	before := len(s.comments)
+	s.comments = filter(s.comments, func(c comment) bool { return id(c.ID) != match[1] })
+	deleted := len(s.comments) < before
+	s.mu.Unlock()
+	if !deleted {
+		writeError(w, http.StatusNotFound, "Comment not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// setCommentBody sets the body of a comment and attaches the files with
+// the comma separated refs, named after the ref.
+func setCommentBody(c *teamwork.Comment, body, pendingFileAttachments string) {
+	c.Body = body
+	c.HTMLBody = body
+	for _, ref := range strings.Split(pendingFileAttachments, ",") {
+		if ref = strings.TrimSpace(ref); ref != "" {
+			c.Attachments = append(c.Attachments, teamwork.CommentAttachment{Filename: ref, ID: teamwork.ID(ref)})
+		}
+	}
+	c.AttachmentsCount = teamwork.FlexInt(len(c.Attachments))
+}
+
+// reorderTaskLists sets the position of the task lists of a project and
+// sorts them in that order.
+func (s *Server) reorderTaskLists(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		TaskLists struct {
+			TaskList []struct {
+				ID string `json:"id"`
+			} `json:"todo-list"`
+		} `json:"todo-lists"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	positions := map[string]int{}
+	for position, taskList := range body.TaskLists.TaskList {
+		positions[taskList.ID] = position
+	}
+
+	s.mu.Lock()
+	for i := range s.taskLists {
+		if position, ok := positions[id(s.taskLists[i].ID)]; ok && id(s.taskLists[i].ProjectID) == match[1] {
+			s.taskLists[i].Position = teamwork.FlexInt(position)
+		}
+	}
+	sort.SliceStable(s.taskLists, func(i, j int) bool { return s.taskLists[i].Position < s.taskLists[j].Position })
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) getTimeEntries(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.mu.Lock()
+	timeEntries := filter(s.timeEntries, func(t teamwork.TimeEntry) bool { return true })
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "time-entries", timeEntries)
+}
+
+func (s *Server) getProjectTimeEntries(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	timeEntries := filter(s.timeEntries, func(t teamwork.TimeEntry) bool { return id(t.ProjectID) == match[1] })
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "time-entries", timeEntries)
+}
+
+func (s *Server) getTaskTimeEntries(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	timeEntries := filter(s.timeEntries, func(t teamwork.TimeEntry) bool { return id(t.TaskItemID) == match[1] })
+	s.mu.Unlock()
+	writePage(w, r, s.PageSize, "time-entries", timeEntries)
+}
+
+func (s *Server) createProjectTimeEntry(w http.ResponseWriter, r *http.Request, match []string) {
+	s.createTimeEntry(w, r, match[1], "")
+}
+
+func (s *Server) createTaskTimeEntry(w http.ResponseWriter, r *http.Request, match []string) {
+	s.createTimeEntry(w, r, "", match[1])
+}
+
+// createTimeEntry stores a time entry posted for a project or a task.
+func (s *Server) createTimeEntry(w http.ResponseWriter, r *http.Request, projectID, taskID string) {
+	body := struct {
+		TimeEntry teamwork.CreateTimeEntryOps `json:"time-entry"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	ops := body.TimeEntry
+	if taskID == "" {
+		taskID = ops.TaskID
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	timeEntry := teamwork.TimeEntry{}
+	err := json.Unmarshal(mustJSON(map[string]interface{}{
+		"id":           strconv.Itoa(s.nextID),
+		"description":  ops.Description,
+		"person-id":    ops.PersonID,
+		"hours":        ops.Hours,
+		"minutes":      ops.Minutes,
+		"isbillable":   ops.IsBillable,
+		"project-id":   projectID,
+		"todo-item-id": taskID,
+	}), &timeEntry)
+	if err == nil {
+		s.timeEntries = append(s.timeEntries, timeEntry)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"time-entry": map[string]interface{}{"timeLogId": id(timeEntry.ID), "STATUS": "OK"},
+	})
+}
+
+func (s *Server) getTimeEntry(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	timeEntries := filter(s.timeEntries, func(t teamwork.TimeEntry) bool { return id(t.ID) == match[1] })
+	s.mu.Unlock()
+	writeOne(w, "time-entry", "Time entry", timeEntries)
+}
+
+func (s *Server) updateTimeEntry(w http.ResponseWriter, r *http.Request, match []string) {
+	body := struct {
+		TimeEntry teamwork.UpdateTimeEntryOps `json:"time-entry"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	fields := map[string]interface{}{}
+	json.Unmarshal(mustJSON(body.TimeEntry), &fields)
+	delete(fields, "date")
+	delete(fields, "time")
+	delete(fields, "tags")
+
+	s.mu.Lock()
+	found := false
+	var err error
+	for i := range s.timeEntries {
+		if id(s.timeEntries[i].ID) == match[1] {
+			err = patch(&s.timeEntries[i], fields)
+			found = true
+		}
+	}
+	s.mu.Unlock()
+	if !found {
+		writeError(w, http.StatusNotFound, "Time entry not found", nil)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) deleteTimeEntry(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	before := len(s.timeEntries)
+	s.timeEntries = filter(s.timeEntries, func(t teamwork.TimeEntry) bool { return id(t.ID) != match[1] })
+	deleted := len(s.timeEntries) < before
+	s.mu.Unlock()
+	if !deleted {
+		writeError(w, http.StatusNotFound, "Time entry not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (s *Server) getTotalTime(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.mu.Lock()
+	totalTime := s.totalTime
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"time-totals": totalTime})
+}
+
+func (s *Server) getProjectTotalTime(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	totals := filter(s.projectTime, func(t teamwork.ProjectTotalTime) bool { return id(t.ID) == match[1] })
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"projects": totals})
+}
+
+func (s *Server) getTaskListTotalTime(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	totals := filter(s.listTime, func(t teamwork.ProjectTaskListTotalTime) bool { return id(t.TaskList.ID) == match[1] })
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"projects": totals})
+}
+
+func (s *Server) getTaskTotalTime(w http.ResponseWriter, r *http.Request, match []string) {
+	s.mu.Lock()
+	totals := filter(s.taskTime, func(t teamwork.ProjectTaskTotalTime) bool { return id(t.TaskList.Task.ID) == match[1] })
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"projects": totals})
+}
+
+// filter returns a copy of the items which match.
+func filter[T any](items []T, match func(T) bool) []T {
+	matched := make([]T, 0, len(items))
+	for _, item := range items {
+		if match(item) {
+			matched = append(matched, item)
+		}
+	}
+	return matched
+}
+
+// id formats an ID of any type for comparing with a path.
+func id(v interface{}) string {
+	return fmt.Sprint(v)
+}
+
+// patch overwrites the JSON fields of v with fields.  Nested objects are
+// merged rather than replaced.
+func patch(v interface{}, fields map[string]interface{}) error {
+	merged := map[string]interface{}{}
+	if err := json.Unmarshal(mustJSON(v), &merged); err != nil {
+		return err
+	}
+	merge(merged, fields)
+	return json.Unmarshal(mustJSON(merged), v)
+}
+
+// merge copies fields into dst, merging the nested objects.
+func merge(dst, fields map[string]interface{}) {
+	for name, value := range fields {
+		nested, isObject := value.(map[string]interface{})
+		existing, wasObject := dst[name].(map[string]interface{})
+		if isObject && wasObject {
+			merge(existing, nested)
+			continue
+		}
+		dst[name] = value
+	}
+}
+
+// or returns value, or fallback when value is empty.
+func or[T ~string](value, fallback T) T {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// date parses a date posted in the "YYYYMMDD" format.  A bad date is zero.
+func date(text string) teamwork.Date {
+	d := teamwork.Date{}
+	json.Unmarshal(mustJSON(text), &d)
+	return d
+}
+
+// writePage writes one page of the items under key, with the X-Page(s)
+// headers, according to the page and pageSize query params.
+func writePage[T any](w http.ResponseWriter, r *http.Request, pageSize int, key string, items []T) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	if size, err := strconv.Atoi(r.URL.Query().Get("pageSize")); err == nil && size > 0 {
+		pageSize = size
+	}
+	if pageSize < 1 {
+		pageSize = len(items) + 1
+	}
+	pages := (len(items) + pageSize - 1) / pageSize
+	if pages == 0 {
+		pages = 1
+	}
+
+	start := min((page-1)*pageSize, len(items))
+	end := min(start+pageSize, len(items))
+
+	w.Header().Set("X-Page", strconv.Itoa(page))
+	w.Header().Set("X-Pages", strconv.Itoa(pages))
+	w.Header().Set("X-Records", strconv.Itoa(len(items)))
+	writeJSON(w, http.StatusOK, map[string]interface{}{key: items[start:end]})
+}
+
+// writeOne writes the first of the items under key, or a 404 with the
+// "<name> not found" message TeamWork sends.
+func writeOne[T any](w http.ResponseWriter, key, name string, items []T) {
+	if len(items) == 0 {
+		writeError(w, http.StatusNotFound, name+" not found", nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{key: items[0]})
+}
+
+// writeJSON writes a successful response, adding the STATUS TeamWork includes.
+func writeJSON(w http.ResponseWriter, statusCode int, body map[string]interface{}) {
+	body["STATUS"] = "OK"
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(mustJSON(body))
+}
+
+// writeError writes a failed response the way TeamWork does.
+func writeError(w http.ResponseWriter, statusCode int, message string, header http.Header) {
+	for name, values := range header {
+		w.Header()[name] = values
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(mustJSON(map[string]interface{}{"MESSAGE": message, "STATUS": "Error"}))
+}
+
+// mustJSON marshals v, which is always one of the teamwork types.
+func mustJSON(v interface{}) []byte {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
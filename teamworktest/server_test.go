@@ -0,0 +1,360 @@
+package teamworktest_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/teamwork"
+	"github.com/swill/teamwork/teamworktest"
+)
+
+func ExampleNewServer() {
+	// start a fake TeamWork with a couple of projects
+	server := teamworktest.NewServer()
+	defer server.Close()
+	server.AddProjects(
+		teamwork.Project{ID: "1", Name: "Website", Status: "active"},
+		teamwork.Project{ID: "2", Name: "Mobile App", Status: "active"},
+	)
+
+	// setup the teamwork connection
+	conn, err := server.Connect()
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	projects, pages, err := conn.GetProjects(&teamwork.GetProjectsOps{})
+	if err != nil {
+		fmt.Printf("Error getting Projects: %s", err.Error())
+	}
+
+	fmt.Println("1. Name:", projects[0].Name)
+	fmt.Println("2. Name:", projects[1].Name)
+	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// 1. Name: Website
+	// 2. Name: Mobile App
+	// # of records: 2
+}
+
+func ExampleServer_Fail() {
+	server := teamworktest.NewServer()
+	defer server.Close()
+
+	// setup the teamwork connection
+	conn, err := server.Connect()
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// fail the next call for people
+	server.Fail(teamworktest.Failure{
+		Path:       "/people.json",
+		StatusCode: http.StatusForbidden,
+		Message:    "You do not have permission",
+		Times:      1,
+	})
+
+	_, _, err = conn.GetPeople(&teamwork.GetPeopleOps{})
+	fmt.Println(err.(*teamwork.APIError).Message)
+	_, _, err = conn.GetPeople(&teamwork.GetPeopleOps{})
+	fmt.Println(err)
+	// Output:
+	// You do not have permission
+	// <nil>
+}
+
+func TestServerPagination(t *testing.T) {
+	server := teamworktest.NewServer()
+	defer server.Close()
+	server.PageSize = 2
+	for i := 1; i <= 5; i++ {
+		server.AddTimeEntries(teamwork.TimeEntry{ID: teamwork.ID(fmt.Sprint(i)), ProjectID: "1"})
+	}
+	server.AddTimeEntries(teamwork.TimeEntry{ID: "6", ProjectID: "2"})
+
+	conn, err := server.Connect()
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	page := 3
+	timeEntries, pages, err := conn.GetTimeEntries(&teamwork.GetTimeEntriesOps{Page: &page})
+	if err != nil {
+		t.Fatalf("GetTimeEntries() error = %v", err)
+	}
+	if pages.Page != 3 || pages.Pages != 3 || pages.Records != 6 {
+		t.Errorf("GetTimeEntries() pages = %+v, want page 3 of 3 with 6 records", pages)
+	}
+	if len(timeEntries) != 2 || timeEntries[0].ID != "5" {
+		t.Errorf("GetTimeEntries() = %+v, want time entries 5 and 6", timeEntries)
+	}
+
+	projectTimeEntries, err := conn.GetAllProjectTimeEntries("1", &teamwork.GetTimeEntriesOps{})
+	if err != nil {
+		t.Fatalf("GetAllProjectTimeEntries() error = %v", err)
+	}
+	if len(projectTimeEntries) != 5 {
+		t.Errorf("GetAllProjectTimeEntries() returned %d time entries, want 5", len(projectTimeEntries))
+	}
+}
+
+func TestServerUnauthorized(t *testing.T) {
+	server := teamworktest.NewServer()
+	defer server.Close()
+
+	_, err := teamwork.Connect(server.URL, "the wrong token")
+	if !teamwork.IsUnauthorized(err) {
+		t.Errorf("Connect() error = %v, want unauthorized", err)
+	}
+}
+
+func TestServerPeople(t *testing.T) {
+	server := teamworktest.NewServer()
+	defer server.Close()
+	server.AddPeople(
+		teamwork.Person{ID: "1", FirstName: "Jane", CompanyID: "10", Projects: []teamwork.ID{"100"}},
+		teamwork.Person{ID: "2", FirstName: "John", CompanyID: "20", Projects: []teamwork.ID{"100", "200"}},
+	)
+	server.SetCurrentPerson("2")
+
+	conn, err := server.Connect()
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	me, err := conn.GetCurrentPerson()
+	if err != nil || me.FirstName != "John" {
+		t.Errorf("GetCurrentPerson() = %q, %v, want John", me.FirstName, err)
+	}
+	companyPeople, _, err := conn.GetCompanyPeople("10", &teamwork.GetPeopleOps{})
+	if err != nil || len(companyPeople) != 1 || companyPeople[0].FirstName != "Jane" {
+		t.Errorf("GetCompanyPeople() = %+v, %v, want Jane", companyPeople, err)
+	}
+	projectPeople, _, err := conn.GetProjectPeople("100", &teamwork.GetPeopleOps{})
+	if err != nil || len(projectPeople) != 2 {
+		t.Errorf("GetProjectPeople() = %+v, %v, want 2 people", projectPeople, err)
+	}
+	if _, err := conn.GetPerson("3"); !teamwork.IsNotFound(err) {
+		t.Errorf("GetPerson() error = %v, want not found", err)
+	}
+}
+
+func TestServerTimeEntries(t *testing.T) {
+	server := teamworktest.NewServer()
+	defer server.Close()
+
+	conn, err := server.Connect()
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	created, err := conn.CreateTimeEntryForProject("100", &teamwork.CreateTimeEntryOps{
+		Description: "Fixed the build",
+		PersonID:    "1",
+		Date:        "20200603",
+		Time:        "15:21",
+		Hours:       "1",
+		Minutes:     "30",
+	})
+	if err != nil {
+		t.Fatalf("CreateTimeEntryForProject() error = %v", err)
+	}
+	if created.ID == "" {
+		t.Fatalf("CreateTimeEntryForProject() returned no ID")
+	}
+	timeEntries, _, err := conn.GetProjectTimeEntries("100", &teamwork.GetTimeEntriesOps{})
+	if err != nil || len(timeEntries) != 1 || timeEntries[0].Description != "Fixed the build" {
+		t.Errorf("GetProjectTimeEntries() = %+v, %v, want the created time entry", timeEntries, err)
+	}
+
+	if _, err := conn.DeleteTimeEntry(created.ID.String()); err != nil {
+		t.Fatalf("DeleteTimeEntry() error = %v", err)
+	}
+	if len(server.TimeEntries()) != 0 {
+		t.Errorf("DeleteTimeEntry() left %d time entries", len(server.TimeEntries()))
+	}
+	if _, err := conn.DeleteTimeEntry(created.ID.String()); !teamwork.IsNotFound(err) {
+		t.Errorf("DeleteTimeEntry() error = %v, want not found", err)
+	}
+}
+
+func TestServerTaskLists(t *testing.T) {
+	server := teamworktest.NewServer()
+	defer server.Close()
+	server.AddProjects(teamwork.Project{ID: "1", Name: "Website"})
+	conn, err := server.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, name := range []string{"Design", "Build", "Launch"} {
+		createResponse, err := conn.CreateTaskList("1", &teamwork.CreateTaskListOps{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, createResponse.ID.String())
+	}
+	if _, err := conn.ReorderTaskLists("1", []string{ids[2], ids[0], ids[1]}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.UpdateTaskList(ids[1], &teamwork.UpdateTaskListOps{Name: "Develop"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.DeleteTaskList(ids[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	taskLists, _, err := conn.GetProjectTaskLists("1", &teamwork.GetProjectTaskListsOps{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, taskList := range taskLists {
+		names = append(names, taskList.Name)
+	}
+	if got, want := strings.Join(names, ","), "Launch,Develop"; got != want {
+		t.Errorf("task lists = %s, want %s", got, want)
+	}
+
+	if _, err := conn.GetTaskList(ids[0]); !teamwork.IsNotFound(err) {
+		t.Errorf("GetTaskList of a deleted task list = %v, want not found", err)
+	}
+}
+
+func TestServerUpdatePerson(t *testing.T) {
+	server := teamworktest.NewServer()
+	defer server.Close()
+	conn, err := server.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := conn.CreatePerson(&teamwork.CreatePersonOps{
+		FirstName:    "Sam",
+		EmailAddress: "sam@example.com",
+		LanguageCode: "EN",
+		TimezoneID:   "27",
+	})
+	if err != nil {
+		t.Fatalf("CreatePerson() error = %v", err)
+	}
+	if _, err := conn.UpdatePerson(created.ID.String(), &teamwork.UpdatePersonOps{Title: "Engineer", TimezoneID: "30"}); err != nil {
+		t.Fatalf("UpdatePerson() error = %v", err)
+	}
+
+	person, err := conn.GetPerson(created.ID.String())
+	if err != nil {
+		t.Fatalf("GetPerson() error = %v", err)
+	}
+	if person.FirstName != "Sam" || person.Title != "Engineer" || person.UserName != "sam@example.com" {
+		t.Errorf("GetPerson() = %q, %q, %q, want Sam, Engineer, sam@example.com", person.FirstName, person.Title, person.UserName)
+	}
+	if person.Localization.LanguageCode != "EN" || person.Localization.TimezoneID != "30" {
+		t.Errorf("GetPerson() localization = %+v, want EN in timezone 30", person.Localization)
+	}
+}
+
+func TestServerProjectPeople(t *testing.T) {
+	server := teamworktest.NewServer()
+	defer server.Close()
+	server.AddProjects(teamwork.Project{ID: "100", Name: "Website"})
+	server.AddPeople(
+		teamwork.Person{ID: "1", FirstName: "Jane", Projects: []teamwork.ID{"100"}},
+		teamwork.Person{ID: "2", FirstName: "John"},
+		teamwork.Person{ID: "3", FirstName: "Joan"},
+	)
+	conn, err := server.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	projectPeople := func() string {
+		t.Helper()
+		people, err := conn.GetAllProjectPeople("100", nil)
+		if err != nil {
+			t.Fatalf("GetAllProjectPeople() error = %v", err)
+		}
+		var names []string
+		for _, person := range people {
+			names = append(names, person.FirstName)
+		}
+		return strings.Join(names, ",")
+	}
+
+	if _, err := conn.AddPeopleToProject("100", []string{"2", "3"}); err != nil {
+		t.Fatalf("AddPeopleToProject() error = %v", err)
+	}
+	if got, want := projectPeople(), "Jane,John,Joan"; got != want {
+		t.Errorf("after AddPeopleToProject() people = %s, want %s", got, want)
+	}
+	if _, err := conn.RemovePersonFromProject("100", "1"); err != nil {
+		t.Fatalf("RemovePersonFromProject() error = %v", err)
+	}
+	if _, err := conn.SetProjectPeople("100", []string{"1", "3"}); err != nil {
+		t.Fatalf("SetProjectPeople() error = %v", err)
+	}
+	if got, want := projectPeople(), "Jane,Joan"; got != want {
+		t.Errorf("after SetProjectPeople() people = %s, want %s", got, want)
+	}
+
+	perms := &teamwork.ProjectPermissions{ViewTasksAndMilestones: true, AddTasks: true}
+	if _, err := conn.UpdateProjectPermissions("100", "3", perms); err != nil {
+		t.Fatalf("UpdateProjectPermissions() error = %v", err)
+	}
+	people, err := conn.GetAllProjectPeople("100", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, person := range people {
+		got := person.Permissions.ProjectPermissions
+		if person.ID == "3" && (!got.AddTasks || !got.ViewTasksAndMilestones || got.ProjectAdministrator) {
+			t.Errorf("permissions of %s = %+v, want %+v", person.FirstName, got, *perms)
+		}
+	}
+	if _, err := conn.UpdateProjectPermissions("100", "2", perms); !teamwork.IsNotFound(err) {
+		t.Errorf("UpdateProjectPermissions() of a person not on the project error = %v, want not found", err)
+	}
+}
+
+func TestServerComments(t *testing.T) {
+	server := teamworktest.NewServer()
+	defer server.Close()
+	server.AddTasks(teamwork.Task{ID: "10"})
+	server.AddComments("milestones", "20", teamwork.Comment{ID: "1", Body: "Seeded"})
+	conn, err := server.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.CreateComment("tasks", "10", &teamwork.CreateCommentOps{Body: "First"}); err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+	tasks, _, err := conn.GetTasks(&teamwork.GetTasksOps{})
+	if err != nil || len(tasks) != 1 || tasks[0].CommentsCount != 1 {
+		t.Errorf("GetTasks() = %+v, %v, want a task with 1 comment", tasks, err)
+	}
+	if _, err := conn.CreateComment("tasks", "11", &teamwork.CreateCommentOps{Body: "Lost"}); !teamwork.IsNotFound(err) {
+		t.Errorf("CreateComment() on a missing task error = %v, want not found", err)
+	}
+
+	if _, err := conn.MarkCommentRead("1"); err != nil {
+		t.Fatalf("MarkCommentRead() error = %v", err)
+	}
+	comments := server.Comments("milestones", "20")
+	if len(comments) != 1 || !comments[0].HasRead {
+		t.Errorf("Comments() = %+v, want the seeded comment read", comments)
+	}
+	if _, err := conn.DeleteComment("1"); err != nil {
+		t.Fatalf("DeleteComment() error = %v", err)
+	}
+	if _, err := conn.MarkCommentRead("1"); !teamwork.IsNotFound(err) {
+		t.Errorf("MarkCommentRead() of a deleted comment error = %v, want not found", err)
+	}
+}
@@ -1,9 +1,12 @@
 package teamwork
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,40 +15,45 @@ type TimeEntries []TimeEntry
 
 // TimeEntry is a description of a time entry
 type TimeEntry struct {
-	CanEdit             bool          `json:"canEdit"`
-	CompanyID           string        `json:"company-id"`
+	CanEdit             FlexBool      `json:"canEdit"`
+	CompanyID           ID            `json:"company-id"`
 	CompanyName         string        `json:"company-name"`
 	CreatedAt           time.Time     `json:"createdAt"`
 	Date                time.Time     `json:"date"`
 	DateUserPerspective time.Time     `json:"dateUserPerspective"`
 	Description         string        `json:"description"`
-	HasStartTime        string        `json:"has-start-time"`
-	Hours               string        `json:"hours"`
-	ID                  string        `json:"id"`
-	InvoiceNo           string        `json:"invoiceNo"`
-	IsBillable          string        `json:"isbillable"`
-	IsBilled            string        `json:"isbilled"`
-	Minutes             string        `json:"minutes"`
-	ParentTaskID        string        `json:"parentTaskId"`
+	HasStartTime        FlexBool      `json:"has-start-time"`
+	Hours               FlexString    `json:"hours"`
+	ID                  ID            `json:"id"`
+	InvoiceNo           FlexString    `json:"invoiceNo"`
+	IsBillable          FlexBool      `json:"isbillable"`
+	IsBilled            FlexBool      `json:"isbilled"`
+	Minutes             FlexString    `json:"minutes"`
+	ParentTaskID        ID            `json:"parentTaskId"`
 	ParentTaskName      string        `json:"parentTaskName"`
 	PersonFirstName     string        `json:"person-first-name"`
-	PersonID            string        `json:"person-id"`
+	PersonID            ID            `json:"person-id"`
 	PersonLastName      string        `json:"person-last-name"`
-	ProjectID           string        `json:"project-id"`
+	ProjectID           ID            `json:"project-id"`
 	ProjectName         string        `json:"project-name"`
 	ProjectStatus       string        `json:"project-status"`
 	Tags                []interface{} `json:"tags"`
 	TaskEstimatedTime   string        `json:"taskEstimatedTime"`
-	TaskIsPrivate       string        `json:"taskIsPrivate"`
-	TaskIsSubTask       string        `json:"taskIsSubTask"`
-	TaskItemID          string        `json:"todo-item-id"`
+	TaskIsPrivate       FlexBool      `json:"taskIsPrivate"`
+	TaskIsSubTask       FlexBool      `json:"taskIsSubTask"`
+	TaskItemID          ID            `json:"todo-item-id"`
 	TaskItemName        string        `json:"todo-item-name"`
-	TaskListID          string        `json:"todo-list-id"`
+	TaskListID          ID            `json:"todo-list-id"`
 	TaskListName        string        `json:"todo-list-name"`
-	TicketID            string        `json:"ticket-id"`
+	TicketID            ID            `json:"ticket-id"`
 	UpdatedDate         time.Time     `json:"updated-date"`
 }
 
+// Duration is the time logged by the time entry.
+func (t TimeEntry) Duration() time.Duration {
+	return sumDuration(string(t.Hours), "") + sumDuration("", string(t.Minutes))
+}
+
 // GetTimeEntriesOps is used to generate the query params for the
 // GetTimeEntries API call.
 type GetTimeEntriesOps struct {
@@ -72,7 +80,7 @@ type GetTimeEntriesOps struct {
 	// Valid Input: "ASC", "DESC"
 	SortOrder string `param:"sortorder"`
 	// Return time logs for a specific user only
-	UserID int `param:"userId"`
+	UserID int `param:"userId,omitempty"`
 	// Filter the Time Entries to those that are Billable or Not Billable.
 	// Valid Input: "billable", "nonbillable"
 	BillableType string `param:"billableType"`
@@ -114,16 +122,40 @@ type CreateTimeEntryOps struct {
 
 // CreateTimeEntryResponse captures the response returned from a create time entry action
 type CreateTimeEntryResponse struct {
-	ID     string `json:"timeLogId"`
+	ID     ID     `json:"timeLogId"`
 	Status string `json:"STATUS"`
 }
 
 // CreateTimeEntryResponseHack captures the response from the API, but can't be used due to the broken api types
+//
+// Deprecated: CreateTimeEntryResponse decodes the timeLogId as an ID, whether it is a string or a number.
 type CreateTimeEntryResponseHack struct {
 	ID     int    `json:"timeLogId"`
 	Status string `json:"STATUS"`
 }
 
+// UpdateTimeEntryOps is used to generate the body for the
+// UpdateTimeEntry API call.  Only the fields which are set are updated.
+type UpdateTimeEntryOps struct {
+	// Description of time entry
+	Description string `json:"description,omitempty"`
+	// ID of the user for the entry
+	PersonID string `json:"person-id,omitempty"`
+	// Start Date of the time entry in YYYYMMDD format
+	Date string `json:"date,omitempty"`
+	// Start Time of the time entry in HH:MM:SS format
+	Time string `json:"time,omitempty"`
+	// Hours logged for the time entry
+	Hours string `json:"hours,omitempty"`
+	// Minutes logged for the time entry
+	Minutes string `json:"minutes,omitempty"`
+	// billable flag
+	// Valid Input: true, false
+	IsBillable string `json:"isbillable,omitempty"`
+	// A comma separated list of tag names.
+	Tags string `json:"tags,omitempty"`
+}
+
 // DeleteTimeEntryResponse captures the response returned from an update time entry action
 type DeleteTimeEntryResponse struct {
 	Status string `json:"STATUS"`
@@ -134,19 +166,24 @@ type DeleteTimeEntryResponse struct {
 //
 // ref: http://developer.teamwork.com/timetracking#retrieve_all_time
 func (conn *Connection) GetTimeEntries(ops *GetTimeEntriesOps) (TimeEntries, Pages, error) {
+	return conn.GetTimeEntriesContext(context.Background(), ops)
+}
+
+// GetTimeEntriesContext is like GetTimeEntries but uses ctx for the request.
+func (conn *Connection) GetTimeEntriesContext(ctx context.Context, ops *GetTimeEntriesOps) (TimeEntries, Pages, error) {
 	timeEntries := make(TimeEntries, 0)
 	pages := &Pages{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%stime_entries.json%s", conn.Account.Url, params)
-	reader, headers, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%stime_entries.json%s", conn.baseURL, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return timeEntries, *pages, err
 	}
-	// data, _ := ioutil.ReadAll(reader)
-	// fmt.Printf(string(data))
-	getHeaders(headers, pages)
 	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return timeEntries, *pages, err
+	}
 
 	err = json.NewDecoder(reader).Decode(&struct {
 		*TimeEntries `json:"time-entries"`
@@ -158,24 +195,63 @@ func (conn *Connection) GetTimeEntries(ops *GetTimeEntriesOps) (TimeEntries, Pag
 	return timeEntries, *pages, nil
 }
 
+// AllTimeEntries returns an iterator over all the time entries according to the
+// specified GetTimeEntriesOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllTimeEntries(ctx context.Context, ops *GetTimeEntriesOps) iter.Seq2[TimeEntry, error] {
+	return conn.timeEntriesPager(ops).all(ctx)
+}
+
+// timeEntriesPager fetches the pages for AllTimeEntries and GetAllTimeEntries.
+func (conn *Connection) timeEntriesPager(ops *GetTimeEntriesOps) pager[TimeEntry] {
+	pageOps := GetTimeEntriesOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[TimeEntry]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]TimeEntry, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetTimeEntriesContext(ctx, &ops)
+		},
+	}
+}
+
+// GetAllTimeEntries gets all the time entries according to the specified
+// GetTimeEntriesOps, walking every page.
+func (conn *Connection) GetAllTimeEntries(ops *GetTimeEntriesOps) (TimeEntries, error) {
+	return conn.GetAllTimeEntriesContext(context.Background(), ops)
+}
+
+// GetAllTimeEntriesContext is like GetAllTimeEntries but uses ctx for the requests.
+func (conn *Connection) GetAllTimeEntriesContext(ctx context.Context, ops *GetTimeEntriesOps) (TimeEntries, error) {
+	return conn.timeEntriesPager(ops).collect(ctx, conn.pageWorkers)
+}
+
 // GetProjectTimeEntries gets all the time entries available for a specific project
 // according to the specified GetTimeEntriesOps which are passed in.
 //
 // ref: http://developer.teamwork.com/timetracking#retrieve_all_time
 func (conn *Connection) GetProjectTimeEntries(id string, ops *GetTimeEntriesOps) (TimeEntries, Pages, error) {
+	return conn.GetProjectTimeEntriesContext(context.Background(), id, ops)
+}
+
+// GetProjectTimeEntriesContext is like GetProjectTimeEntries but uses ctx for the request.
+func (conn *Connection) GetProjectTimeEntriesContext(ctx context.Context, id string, ops *GetTimeEntriesOps) (TimeEntries, Pages, error) {
 	timeEntries := make(TimeEntries, 0)
 	pages := &Pages{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%sprojects/%s/time_entries.json%s", conn.Account.Url, id, params)
-	reader, headers, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%sprojects/%s/time_entries.json%s", conn.baseURL, id, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return timeEntries, *pages, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
-	getHeaders(headers, pages)
 	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return timeEntries, *pages, err
+	}
 
 	err = json.NewDecoder(reader).Decode(&struct {
 		*TimeEntries `json:"time-entries"`
@@ -187,35 +263,52 @@ func (conn *Connection) GetProjectTimeEntries(id string, ops *GetTimeEntriesOps)
 	return timeEntries, *pages, nil
 }
 
+// AllProjectTimeEntries returns an iterator over all the time entries for a project according to the
+// specified GetTimeEntriesOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllProjectTimeEntries(ctx context.Context, id string, ops *GetTimeEntriesOps) iter.Seq2[TimeEntry, error] {
+	return conn.projectTimeEntriesPager(id, ops).all(ctx)
+}
+
+// projectTimeEntriesPager fetches the pages for AllProjectTimeEntries and GetAllProjectTimeEntries.
+func (conn *Connection) projectTimeEntriesPager(id string, ops *GetTimeEntriesOps) pager[TimeEntry] {
+	pageOps := GetTimeEntriesOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[TimeEntry]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]TimeEntry, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetProjectTimeEntriesContext(ctx, id, &ops)
+		},
+	}
+}
+
+// GetAllProjectTimeEntries gets all the time entries for a project according to the specified
+// GetTimeEntriesOps, walking every page.
+func (conn *Connection) GetAllProjectTimeEntries(id string, ops *GetTimeEntriesOps) (TimeEntries, error) {
+	return conn.GetAllProjectTimeEntriesContext(context.Background(), id, ops)
+}
+
+// GetAllProjectTimeEntriesContext is like GetAllProjectTimeEntries but uses ctx for the requests.
+func (conn *Connection) GetAllProjectTimeEntriesContext(ctx context.Context, id string, ops *GetTimeEntriesOps) (TimeEntries, error) {
+	return conn.projectTimeEntriesPager(id, ops).collect(ctx, conn.pageWorkers)
+}
+
 // CreateTimeEntryForProject creates a time entry for a project
 // according to the specified CreateTimeEntryOps which are passed in
 //
 // ref: https://developer.teamwork.com/projects/api-v1/ref/time-tracking/post-projects-id-time-entries-json
 func (conn *Connection) CreateTimeEntryForProject(projectID string, ops *CreateTimeEntryOps) (*CreateTimeEntryResponse, error) {
-	jsonBody, err := json.Marshal(struct {
-		TimeEntry *CreateTimeEntryOps `json:"time-entry"`
-	}{TimeEntry: ops})
-	// fmt.Println(string(jsonBody))
-	createResponse := &CreateTimeEntryResponse{}
-	method := "POST"
-	url := fmt.Sprintf("%sprojects/%s/time_entries.json", conn.Account.Url, projectID)
-	reader, _, err := request(conn.ApiToken, method, url, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return nil, err
-	}
-	// data, _ := ioutil.ReadAll(reader)
-	// fmt.Printf(string(data))
-	// getHeaders(headers, pages)
-	defer reader.Close()
-
-	err = json.NewDecoder(reader).Decode(&struct {
-		*CreateTimeEntryResponse `json:"time-entry"`
-	}{createResponse})
-	if err != nil {
-		return nil, err
-	}
+	return conn.CreateTimeEntryForProjectContext(context.Background(), projectID, ops)
+}
 
-	return createResponse, nil
+// CreateTimeEntryForProjectContext is like CreateTimeEntryForProject but uses ctx for the request.
+func (conn *Connection) CreateTimeEntryForProjectContext(ctx context.Context, projectID string, ops *CreateTimeEntryOps) (*CreateTimeEntryResponse, error) {
+	url := fmt.Sprintf("%sprojects/%s/time_entries.json", conn.baseURL, projectID)
+	return conn.createTimeEntry(ctx, url, ops)
 }
 
 // CreateTimeEntryForTask creates a time entry for a task
@@ -223,43 +316,94 @@ func (conn *Connection) CreateTimeEntryForProject(projectID string, ops *CreateT
 //
 // ref: https://developer.teamwork.com/projects/api-v1/ref/time-tracking/post-projects-id-time-entries-json
 func (conn *Connection) CreateTimeEntryForTask(taskID string, ops *CreateTimeEntryOps) (*CreateTimeEntryResponse, error) {
-	jsonBody, err := json.Marshal(struct {
-		TimeEntry *CreateTimeEntryOps `json:"time-entry"`
-	}{TimeEntry: ops})
-	// fmt.Println(string(jsonBody))
+	return conn.CreateTimeEntryForTaskContext(context.Background(), taskID, ops)
+}
+
+// CreateTimeEntryForTaskContext is like CreateTimeEntryForTask but uses ctx for the request.
+func (conn *Connection) CreateTimeEntryForTaskContext(ctx context.Context, taskID string, ops *CreateTimeEntryOps) (*CreateTimeEntryResponse, error) {
+	url := fmt.Sprintf("%stasks/%s/time_entries.json", conn.baseURL, taskID)
+	return conn.createTimeEntry(ctx, url, ops)
+}
+
+// createTimeEntry posts a time entry to url for CreateTimeEntryForProject
+// and CreateTimeEntryForTask.
+func (conn *Connection) createTimeEntry(ctx context.Context, url string, ops *CreateTimeEntryOps) (*CreateTimeEntryResponse, error) {
 	createResponse := &CreateTimeEntryResponse{}
-	method := "POST"
-	url := fmt.Sprintf("%stasks/%s/time_entries.json", conn.Account.Url, taskID)
-	reader, _, err := request(conn.ApiToken, method, url, bytes.NewBuffer(jsonBody))
+	err := conn.sendJSON(ctx, "POST", url, struct {
+		TimeEntry *CreateTimeEntryOps `json:"time-entry"`
+	}{ops}, &struct {
+		*CreateTimeEntryResponse `json:"time-entry"`
+	}{createResponse})
 	if err != nil {
 		return nil, err
 	}
-	// data, _ := ioutil.ReadAll(reader)
-	// fmt.Printf(string(data))
-	// getHeaders(headers, pages)
+	return createResponse, nil
+}
+
+// GetTimeEntry gets a single time entry based on the ID.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/time-tracking/get-time-entries-id-json
+func (conn *Connection) GetTimeEntry(id string) (TimeEntry, error) {
+	return conn.GetTimeEntryContext(context.Background(), id)
+}
+
+// GetTimeEntryContext is like GetTimeEntry but uses ctx for the request.
+func (conn *Connection) GetTimeEntryContext(ctx context.Context, id string) (TimeEntry, error) {
+	timeEntry := &TimeEntry{}
+	method := "GET"
+	url := fmt.Sprintf("%stime_entries/%s.json", conn.baseURL, id)
+	reader, _, err := conn.request(ctx, method, url, nil)
+	if err != nil {
+		return *timeEntry, err
+	}
 	defer reader.Close()
 
-	err = json.NewDecoder(reader).Decode(&createResponse)
+	err = json.NewDecoder(reader).Decode(&struct {
+		*TimeEntry `json:"time-entry"`
+	}{timeEntry})
 	if err != nil {
-		return nil, err
+		return *timeEntry, err
 	}
 
-	return createResponse, nil
+	return *timeEntry, nil
+}
+
+// UpdateTimeEntry updates a specific time entry, keeping its ID,
+// according to the specified UpdateTimeEntryOps which are passed in.
+//
+// ref: https://developer.teamwork.com/projects/api-v1/ref/time-tracking/put-time-entries-id-json
+func (conn *Connection) UpdateTimeEntry(id string, ops *UpdateTimeEntryOps) (*StatusResponse, error) {
+	return conn.UpdateTimeEntryContext(context.Background(), id, ops)
+}
+
+// UpdateTimeEntryContext is like UpdateTimeEntry but uses ctx for the request.
+func (conn *Connection) UpdateTimeEntryContext(ctx context.Context, id string, ops *UpdateTimeEntryOps) (*StatusResponse, error) {
+	updateResponse := &StatusResponse{}
+	url := fmt.Sprintf("%stime_entries/%s.json", conn.baseURL, id)
+	err := conn.sendJSON(ctx, "PUT", url, struct {
+		TimeEntry *UpdateTimeEntryOps `json:"time-entry"`
+	}{ops}, updateResponse)
+	if err != nil {
+		return nil, err
+	}
+	return updateResponse, nil
 }
 
 // DeleteTimeEntry deletes a specific time entry
 //
 // ref: https://developer.teamwork.com/projects/api-v1/ref/time-tracking/delete-time-entries-id-json
 func (conn *Connection) DeleteTimeEntry(id string) (*DeleteTimeEntryResponse, error) {
+	return conn.DeleteTimeEntryContext(context.Background(), id)
+}
+
+// DeleteTimeEntryContext is like DeleteTimeEntry but uses ctx for the request.
+func (conn *Connection) DeleteTimeEntryContext(ctx context.Context, id string) (*DeleteTimeEntryResponse, error) {
 	method := "DELETE"
-	url := fmt.Sprintf("%stime_entries/%s.json", conn.Account.Url, id)
-	reader, _, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%stime_entries/%s.json", conn.baseURL, id)
+	reader, _, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return nil, err
 	}
-	// data, _ := ioutil.ReadAll(reader)
-	// fmt.Printf(string(data))
-	// getHeaders(headers, pages)
 	defer reader.Close()
 
 	deleteResponse := &DeleteTimeEntryResponse{}
@@ -268,8 +412,6 @@ func (conn *Connection) DeleteTimeEntry(id string) (*DeleteTimeEntryResponse, er
 		return nil, err
 	}
 
-	// fmt.Printf("%+v", deleteResponse)
-
 	return deleteResponse, nil
 }
 
@@ -278,19 +420,24 @@ func (conn *Connection) DeleteTimeEntry(id string) (*DeleteTimeEntryResponse, er
 //
 // ref: http://developer.teamwork.com/timetracking#retrieve_all_time
 func (conn *Connection) GetTaskTimeEntries(id string, ops *GetTimeEntriesOps) (TimeEntries, Pages, error) {
+	return conn.GetTaskTimeEntriesContext(context.Background(), id, ops)
+}
+
+// GetTaskTimeEntriesContext is like GetTaskTimeEntries but uses ctx for the request.
+func (conn *Connection) GetTaskTimeEntriesContext(ctx context.Context, id string, ops *GetTimeEntriesOps) (TimeEntries, Pages, error) {
 	timeEntries := make(TimeEntries, 0)
 	pages := &Pages{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%stasks/%s/time_entries.json%s", conn.Account.Url, id, params)
-	reader, headers, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%stasks/%s/time_entries.json%s", conn.baseURL, id, params)
+	reader, headers, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return timeEntries, *pages, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
-	getHeaders(headers, pages)
 	defer reader.Close()
+	if err := conn.getPages(headers, pages); err != nil {
+		return timeEntries, *pages, err
+	}
 
 	err = json.NewDecoder(reader).Decode(&struct {
 		*TimeEntries `json:"time-entries"`
@@ -302,6 +449,40 @@ func (conn *Connection) GetTaskTimeEntries(id string, ops *GetTimeEntriesOps) (T
 	return timeEntries, *pages, nil
 }
 
+// AllTaskTimeEntries returns an iterator over all the time entries for a task according to the
+// specified GetTimeEntriesOps, fetching each page as it is needed.
+// Iteration stops at the first error or when ctx is done.
+func (conn *Connection) AllTaskTimeEntries(ctx context.Context, id string, ops *GetTimeEntriesOps) iter.Seq2[TimeEntry, error] {
+	return conn.taskTimeEntriesPager(id, ops).all(ctx)
+}
+
+// taskTimeEntriesPager fetches the pages for AllTaskTimeEntries and GetAllTaskTimeEntries.
+func (conn *Connection) taskTimeEntriesPager(id string, ops *GetTimeEntriesOps) pager[TimeEntry] {
+	pageOps := GetTimeEntriesOps{}
+	if ops != nil {
+		pageOps = *ops
+	}
+	return pager[TimeEntry]{
+		start: startPage(pageOps.Page),
+		fetch: func(ctx context.Context, page int) ([]TimeEntry, Pages, error) {
+			ops := pageOps
+			ops.Page = &page
+			return conn.GetTaskTimeEntriesContext(ctx, id, &ops)
+		},
+	}
+}
+
+// GetAllTaskTimeEntries gets all the time entries for a task according to the specified
+// GetTimeEntriesOps, walking every page.
+func (conn *Connection) GetAllTaskTimeEntries(id string, ops *GetTimeEntriesOps) (TimeEntries, error) {
+	return conn.GetAllTaskTimeEntriesContext(context.Background(), id, ops)
+}
+
+// GetAllTaskTimeEntriesContext is like GetAllTaskTimeEntries but uses ctx for the requests.
+func (conn *Connection) GetAllTaskTimeEntriesContext(ctx context.Context, id string, ops *GetTimeEntriesOps) (TimeEntries, error) {
+	return conn.taskTimeEntriesPager(id, ops).collect(ctx, conn.pageWorkers)
+}
+
 // TotalTime over whole account.
 type TotalTime struct {
 	BillableHoursSum    string `json:"billable-hours-sum"`
@@ -316,43 +497,141 @@ type TotalTime struct {
 	TotalMinsSum        string `json:"total-mins-sum"`
 }
 
+// Total is the time logged.
+func (t TotalTime) Total() time.Duration {
+	return sumDuration(t.TotalHoursSum, t.TotalMinsSum)
+}
+
+// Billable is the billable time logged.
+func (t TotalTime) Billable() time.Duration {
+	return sumDuration(t.BillableHoursSum, t.BillableMinsSum)
+}
+
+// Billed is the time logged which has been billed.
+func (t TotalTime) Billed() time.Duration {
+	return sumDuration(t.BilledHoursSum, t.BilledMinsSum)
+}
+
+// NonBillable is the time logged which is not billable.
+func (t TotalTime) NonBillable() time.Duration {
+	return sumDuration(t.NonBillableHoursSum, t.NonBillableMinsSum)
+}
+
+// NonBilled is the time logged which has not been billed.
+func (t TotalTime) NonBilled() time.Duration {
+	return sumDuration(t.NonBilledHoursSum, t.NonBilledMinsSum)
+}
+
+// TimeTotals are the sums of the time logged on a project, task list or task.
+type TimeTotals struct {
+	BillableHoursSum          string `json:"billable-hours-sum"`
+	BillableMinsSum           string `json:"billable-mins-sum"`
+	BilledHoursSum            string `json:"billed-hours-sum"`
+	BilledMinsSum             string `json:"billed-mins-sum"`
+	FilteredEstimatedHoursSum string `json:"filtered-estimated-hours-sum"`
+	FilteredEstimatedMinsSum  string `json:"filtered-estimated-mins-sum"`
+	NonBillableHoursSum       string `json:"non-billable-hours-sum"`
+	NonBillableMinsSum        string `json:"non-billable-mins-sum"`
+	NonBilledHoursSum         string `json:"non-billed-hours-sum"`
+	NonBilledMinsSum          string `json:"non-billed-mins-sum"`
+	TotalHoursSum             string `json:"total-hours-sum"`
+	TotalMinsSum              string `json:"total-mins-sum"`
+}
+
+// Total is the time logged.
+func (t TimeTotals) Total() time.Duration {
+	return sumDuration(t.TotalHoursSum, t.TotalMinsSum)
+}
+
+// Billable is the billable time logged.
+func (t TimeTotals) Billable() time.Duration {
+	return sumDuration(t.BillableHoursSum, t.BillableMinsSum)
+}
+
+// Billed is the time logged which has been billed.
+func (t TimeTotals) Billed() time.Duration {
+	return sumDuration(t.BilledHoursSum, t.BilledMinsSum)
+}
+
+// NonBillable is the time logged which is not billable.
+func (t TimeTotals) NonBillable() time.Duration {
+	return sumDuration(t.NonBillableHoursSum, t.NonBillableMinsSum)
+}
+
+// NonBilled is the time logged which has not been billed.
+func (t TimeTotals) NonBilled() time.Duration {
+	return sumDuration(t.NonBilledHoursSum, t.NonBilledMinsSum)
+}
+
+// FilteredEstimated is the estimated time of the tasks matching the filter.
+func (t TimeTotals) FilteredEstimated() time.Duration {
+	return sumDuration(t.FilteredEstimatedHoursSum, t.FilteredEstimatedMinsSum)
+}
+
+// TimeEstimates are the sums of the estimated time of the tasks in a
+// project, task list or task.
+type TimeEstimates struct {
+	ActiveHoursEstimated                string `json:"active-hours-estimated"`
+	ActiveMinsEstimated                 string `json:"active-mins-estimated"`
+	CompletedHoursEstimated             string `json:"completed-hours-estimated"`
+	CompletedMinsEstimated              string `json:"completed-mins-estimated"`
+	FilteredEstimatedHoursSum           string `json:"filtered-estimated-hours-sum"`
+	FilteredEstimatedMinsSum            string `json:"filtered-estimated-mins-sum"`
+	TotalHoursEstimated                 string `json:"total-hours-estimated"`
+	TotalMinsEstimated                  string `json:"total-mins-estimated"`
+	TotalWithTimeLoggedEstimatedDecimal string `json:"totalWithTimeLoggedEstimatedDecimal"`
+	TotalWithTimeLoggedEstimatedMins    string `json:"totalWithTimeLoggedEstimatedMins"`
+}
+
+// Active is the estimated time of the tasks which are not completed.
+func (t TimeEstimates) Active() time.Duration {
+	return sumDuration(t.ActiveHoursEstimated, t.ActiveMinsEstimated)
+}
+
+// Completed is the estimated time of the completed tasks.
+func (t TimeEstimates) Completed() time.Duration {
+	return sumDuration(t.CompletedHoursEstimated, t.CompletedMinsEstimated)
+}
+
+// FilteredEstimated is the estimated time of the tasks matching the filter.
+func (t TimeEstimates) FilteredEstimated() time.Duration {
+	return sumDuration(t.FilteredEstimatedHoursSum, t.FilteredEstimatedMinsSum)
+}
+
+// Total is the estimated time of all the tasks.
+func (t TimeEstimates) Total() time.Duration {
+	return sumDuration(t.TotalHoursEstimated, t.TotalMinsEstimated)
+}
+
+// TotalWithTimeLogged is the estimated time of the tasks which have time logged.
+func (t TimeEstimates) TotalWithTimeLogged() time.Duration {
+	return sumDuration(t.TotalWithTimeLoggedEstimatedDecimal, t.TotalWithTimeLoggedEstimatedMins)
+}
+
+// sumDuration converts one of TeamWork's sums, which is sent both in decimal
+// hours (eg: "3.50") and in minutes (eg: "210"), to a time.Duration.  The
+// minutes are used when they are set since they are exact.  A sum which is
+// empty or not a number is 0.
+func sumDuration(hours, minutes string) time.Duration {
+	if mins, err := strconv.ParseFloat(strings.TrimSpace(minutes), 64); err == nil {
+		return time.Duration(mins * float64(time.Minute)).Round(time.Second)
+	}
+	if hrs, err := strconv.ParseFloat(strings.TrimSpace(hours), 64); err == nil {
+		return time.Duration(hrs * float64(time.Hour)).Round(time.Minute)
+	}
+	return 0
+}
+
 // ProjectTotalTimes is a list of ProjectTotalTime
 type ProjectTotalTimes []ProjectTotalTime
 
 // ProjectTotalTime describes the time spent on a project
 type ProjectTotalTime struct {
-	Company struct {
-		Name string `json:"name"`
-		ID   string `json:"id"`
-	} `json:"company"`
-	ID            string `json:"id"`
-	Name          string `json:"name"`
-	TimeEstimates struct {
-		ActiveHoursEstimated                string `json:"active-hours-estimated"`
-		ActiveMinsEstimated                 string `json:"active-mins-estimated"`
-		CompletedHoursEstimated             string `json:"completed-hours-estimated"`
-		CompletedMinsEstimated              string `json:"completed-mins-estimated"`
-		FilteredEstimatedHoursSum           string `json:"filtered-estimated-hours-sum"`
-		FilteredEstimatedMinsSum            string `json:"filtered-estimated-mins-sum"`
-		TotalHoursEstimated                 string `json:"total-hours-estimated"`
-		TotalMinsEstimated                  string `json:"total-mins-estimated"`
-		TotalWithTimeLoggedEstimatedDecimal string `json:"totalWithTimeLoggedEstimatedDecimal"`
-		TotalWithTimeLoggedEstimatedMins    string `json:"totalWithTimeLoggedEstimatedMins"`
-	} `json:"time-estimates"`
-	TimeTotals struct {
-		BillableHoursSum          string `json:"billable-hours-sum"`
-		BillableMinsSum           string `json:"billable-mins-sum"`
-		BilledHoursSum            string `json:"billed-hours-sum"`
-		BilledMinsSum             string `json:"billed-mins-sum"`
-		FilteredEstimatedHoursSum string `json:"filtered-estimated-hours-sum"`
-		FilteredEstimatedMinsSum  string `json:"filtered-estimated-mins-sum"`
-		NonBillableHoursSum       string `json:"non-billable-hours-sum"`
-		NonBillableMinsSum        string `json:"non-billable-mins-sum"`
-		NonBilledHoursSum         string `json:"non-billed-hours-sum"`
-		NonBilledMinsSum          string `json:"non-billed-mins-sum"`
-		TotalHoursSum             string `json:"total-hours-sum"`
-		TotalMinsSum              string `json:"total-mins-sum"`
-	} `json:"time-totals"`
+	Company       CompanyRef    `json:"company"`
+	ID            ID            `json:"id"`
+	Name          string        `json:"name"`
+	TimeEstimates TimeEstimates `json:"time-estimates"`
+	TimeTotals    TimeTotals    `json:"time-totals"`
 }
 
 // ProjectTaskListTotalTimes is a list of ProjectTaskListTotalTime
@@ -360,41 +639,14 @@ type ProjectTaskListTotalTimes []ProjectTaskListTotalTime
 
 // ProjectTaskListTotalTime is a description of the TaskList total time
 type ProjectTaskListTotalTime struct {
-	Company struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
-	} `json:"company"`
-	ID       string `json:"id"`
-	Name     string `json:"name"`
+	Company  CompanyRef `json:"company"`
+	ID       ID         `json:"id"`
+	Name     string     `json:"name"`
 	TaskList struct {
-		ID            string `json:"id"`
-		Name          string `json:"name"`
-		TimeEstimates struct {
-			ActiveHoursEstimated                string `json:"active-hours-estimated"`
-			ActiveMinsEstimated                 string `json:"active-mins-estimated"`
-			CompletedHoursEstimated             string `json:"completed-hours-estimated"`
-			CompletedMinsEstimated              string `json:"completed-mins-estimated"`
-			FilteredEstimatedHoursSum           string `json:"filtered-estimated-hours-sum"`
-			FilteredEstimatedMinsSum            string `json:"filtered-estimated-mins-sum"`
-			TotalHoursEstimated                 string `json:"total-hours-estimated"`
-			TotalMinsEstimated                  string `json:"total-mins-estimated"`
-			TotalWithTimeLoggedEstimatedDecimal string `json:"totalWithTimeLoggedEstimatedDecimal"`
-			TotalWithTimeLoggedEstimatedMins    string `json:"totalWithTimeLoggedEstimatedMins"`
-		} `json:"time-estimates"`
-		TimeTotals struct {
-			BillableHoursSum          string `json:"billable-hours-sum"`
-			BillableMinsSum           string `json:"billable-mins-sum"`
-			BilledHoursSum            string `json:"billed-hours-sum"`
-			BilledMinsSum             string `json:"billed-mins-sum"`
-			FilteredEstimatedHoursSum string `json:"filtered-estimated-hours-sum"`
-			FilteredEstimatedMinsSum  string `json:"filtered-estimated-mins-sum"`
-			NonBillableHoursSum       string `json:"non-billable-hours-sum"`
-			NonBillableMinsSum        string `json:"non-billable-mins-sum"`
-			NonBilledHoursSum         string `json:"non-billed-hours-sum"`
-			NonBilledMinsSum          string `json:"non-billed-mins-sum"`
-			TotalHoursSum             string `json:"total-hours-sum"`
-			TotalMinsSum              string `json:"total-mins-sum"`
-		} `json:"time-totals"`
+		ID            ID            `json:"id"`
+		Name          string        `json:"name"`
+		TimeEstimates TimeEstimates `json:"time-estimates"`
+		TimeTotals    TimeTotals    `json:"time-totals"`
 	} `json:"tasklist"`
 }
 
@@ -403,44 +655,17 @@ type ProjectTaskTotalTimes []ProjectTaskTotalTime
 
 // ProjectTaskTotalTime is a description of the total time for a Task
 type ProjectTaskTotalTime struct {
-	Company struct {
-		Name string `json:"name"`
-		ID   string `json:"id"`
-	} `json:"company"`
-	ID       string `json:"id"`
-	Name     string `json:"name"`
+	Company  CompanyRef `json:"company"`
+	ID       ID         `json:"id"`
+	Name     string     `json:"name"`
 	TaskList struct {
-		ID   string `json:"id"`
+		ID   ID     `json:"id"`
 		Name string `json:"name"`
 		Task struct {
-			ID            string `json:"id"`
-			Name          string `json:"name"`
-			TimeEstimates struct {
-				ActiveHoursEstimated                string `json:"active-hours-estimated"`
-				ActiveMinsEstimated                 string `json:"active-mins-estimated"`
-				CompletedHoursEstimated             string `json:"completed-hours-estimated"`
-				CompletedMinsEstimated              string `json:"completed-mins-estimated"`
-				FilteredEstimatedHoursSum           string `json:"filtered-estimated-hours-sum"`
-				FilteredEstimatedMinsSum            string `json:"filtered-estimated-mins-sum"`
-				TotalHoursEstimated                 string `json:"total-hours-estimated"`
-				TotalMinsEstimated                  string `json:"total-mins-estimated"`
-				TotalWithTimeLoggedEstimatedDecimal string `json:"totalWithTimeLoggedEstimatedDecimal"`
-				TotalWithTimeLoggedEstimatedMins    string `json:"totalWithTimeLoggedEstimatedMins"`
-			} `json:"time-estimates"`
-			TimeTotals struct {
-				BillableHoursSum          string `json:"billable-hours-sum"`
-				BillableMinsSum           string `json:"billable-mins-sum"`
-				BilledHoursSum            string `json:"billed-hours-sum"`
-				BilledMinsSum             string `json:"billed-mins-sum"`
-				FilteredEstimatedHoursSum string `json:"filtered-estimated-hours-sum"`
-				FilteredEstimatedMinsSum  string `json:"filtered-estimated-mins-sum"`
-				NonBillableHoursSum       string `json:"non-billable-hours-sum"`
-				NonBillableMinsSum        string `json:"non-billable-mins-sum"`
-				NonBilledHoursSum         string `json:"non-billed-hours-sum"`
-				NonBilledMinsSum          string `json:"non-billed-mins-sum"`
-				TotalHoursSum             string `json:"total-hours-sum"`
-				TotalMinsSum              string `json:"total-mins-sum"`
-			} `json:"time-totals"`
+			ID            ID            `json:"id"`
+			Name          string        `json:"name"`
+			TimeEstimates TimeEstimates `json:"time-estimates"`
+			TimeTotals    TimeTotals    `json:"time-totals"`
 		} `json:"task"`
 	} `json:"tasklist"`
 }
@@ -475,16 +700,19 @@ type GetTotalTimeOps struct {
 //
 // ref: http://developer.teamwork.com/timetracking#time_totals
 func (conn *Connection) GetTotalTime(ops *GetTotalTimeOps) (TotalTime, error) {
+	return conn.GetTotalTimeContext(context.Background(), ops)
+}
+
+// GetTotalTimeContext is like GetTotalTime but uses ctx for the request.
+func (conn *Connection) GetTotalTimeContext(ctx context.Context, ops *GetTotalTimeOps) (TotalTime, error) {
 	totalTime := &TotalTime{}
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%stime/total.json%s", conn.Account.Url, params)
-	reader, _, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%stime/total.json%s", conn.baseURL, params)
+	reader, _, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return *totalTime, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
 	defer reader.Close()
 
 	err = json.NewDecoder(reader).Decode(&struct {
@@ -502,16 +730,19 @@ func (conn *Connection) GetTotalTime(ops *GetTotalTimeOps) (TotalTime, error) {
 //
 // ref: http://developer.teamwork.com/timetracking#time_totals
 func (conn *Connection) GetProjectTotalTime(id string, ops *GetTotalTimeOps) (ProjectTotalTimes, error) {
+	return conn.GetProjectTotalTimeContext(context.Background(), id, ops)
+}
+
+// GetProjectTotalTimeContext is like GetProjectTotalTime but uses ctx for the request.
+func (conn *Connection) GetProjectTotalTimeContext(ctx context.Context, id string, ops *GetTotalTimeOps) (ProjectTotalTimes, error) {
 	projectTotalTime := make(ProjectTotalTimes, 0)
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%sprojects/%s/time/total.json%s", conn.Account.Url, id, params)
-	reader, _, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%sprojects/%s/time/total.json%s", conn.baseURL, id, params)
+	reader, _, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return projectTotalTime, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
 	defer reader.Close()
 
 	err = json.NewDecoder(reader).Decode(&struct {
@@ -529,16 +760,19 @@ func (conn *Connection) GetProjectTotalTime(id string, ops *GetTotalTimeOps) (Pr
 //
 // ref: http://developer.teamwork.com/timetracking#time_totals
 func (conn *Connection) GetTaskListTotalTime(id string, ops *GetTotalTimeOps) (ProjectTaskListTotalTimes, error) {
+	return conn.GetTaskListTotalTimeContext(context.Background(), id, ops)
+}
+
+// GetTaskListTotalTimeContext is like GetTaskListTotalTime but uses ctx for the request.
+func (conn *Connection) GetTaskListTotalTimeContext(ctx context.Context, id string, ops *GetTotalTimeOps) (ProjectTaskListTotalTimes, error) {
 	taskListTotalTime := make(ProjectTaskListTotalTimes, 0)
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%stasklists/%s/time/total.json%s", conn.Account.Url, id, params)
-	reader, _, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%stasklists/%s/time/total.json%s", conn.baseURL, id, params)
+	reader, _, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return taskListTotalTime, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
 	defer reader.Close()
 
 	err = json.NewDecoder(reader).Decode(&struct {
@@ -556,16 +790,19 @@ func (conn *Connection) GetTaskListTotalTime(id string, ops *GetTotalTimeOps) (P
 //
 // ref: http://developer.teamwork.com/timetracking#time_totals
 func (conn *Connection) GetTaskTotalTime(id string, ops *GetTotalTimeOps) (ProjectTaskTotalTimes, error) {
+	return conn.GetTaskTotalTimeContext(context.Background(), id, ops)
+}
+
+// GetTaskTotalTimeContext is like GetTaskTotalTime but uses ctx for the request.
+func (conn *Connection) GetTaskTotalTimeContext(ctx context.Context, id string, ops *GetTotalTimeOps) (ProjectTaskTotalTimes, error) {
 	taskTotalTime := make(ProjectTaskTotalTimes, 0)
 	params := buildParams(ops)
 	method := "GET"
-	url := fmt.Sprintf("%stasks/%s/time/total.json%s", conn.Account.Url, id, params)
-	reader, _, err := request(conn.ApiToken, method, url, nil)
+	url := fmt.Sprintf("%stasks/%s/time/total.json%s", conn.baseURL, id, params)
+	reader, _, err := conn.request(ctx, method, url, nil)
 	if err != nil {
 		return taskTotalTime, err
 	}
-	//data, _ := ioutil.ReadAll(reader)
-	//fmt.Printf(string(data))
 	defer reader.Close()
 
 	err = json.NewDecoder(reader).Decode(&struct {
@@ -1,24 +1,30 @@
 package teamwork_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"testing"
+	"time"
 
 	"github.com/swill/teamwork"
+	"github.com/swill/teamwork/teamworktest"
 )
 
 func ExampleConnection_GetTimeEntries() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetTimeEntries")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
 	}
 
 	// get all time entries
-	Page := 200
+	Page := 1
 	timeEntriesOps := &teamwork.GetTimeEntriesOps{
 		Page: &Page,
 	}
@@ -30,16 +36,25 @@ func ExampleConnection_GetTimeEntries() {
 	fmt.Println("GetTimeEntries")
 	fmt.Println("1. Time for Company Name:", timeEntries[0].CompanyName)
 	fmt.Println("1. Time for Project Name:", timeEntries[0].ProjectName)
+	fmt.Println("1. Time logged:", timeEntries[0].Duration())
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
 	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetTimeEntries
+	// 1. Time for Company Name: Acme
+	// 1. Time for Project Name: Website Redesign
+	// 1. Time logged: 2h30m0s
+	// on page #: 1
+	// # of pages: 1
+	// # of records: 2
 }
 
 func ExampleConnection_GetProjectTimeEntries() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetProjectTimeEntries")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -59,13 +74,21 @@ func ExampleConnection_GetProjectTimeEntries() {
 	fmt.Println("1. Time in Hours:", projectTimeEntries[0].Hours)
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
+	// Output:
+	// GetProjectTimeEntries
+	// 1. Time for Project Name: Website Redesign
+	// 1. Time for Project Description: Wireframe review
+	// 1. Time for Date: 2020-06-03 15:21:00 +0000 UTC
+	// 1. Time in Hours: 2
+	// on page #: 1
+	// # of pages: 1
 }
 
 func ExampleConnection_GetTaskTimeEntries() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetTaskTimeEntries")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -86,13 +109,22 @@ func ExampleConnection_GetTaskTimeEntries() {
 	fmt.Println("on page #:", pages.Page)
 	fmt.Println("# of pages:", pages.Pages)
 	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetTaskTimeEntries
+	// 1. Time for Task List: Design
+	// 1. Time for Task Name: Pick a colour palette
+	// 1. Time for Date: 2020-06-03 15:21:00 +0000 UTC
+	// 1. Time in Hours: 1
+	// on page #: 1
+	// # of pages: 1
+	// # of records: 1
 }
 
 func ExampleConnection_GetTotalTime() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetTotalTime")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -108,13 +140,19 @@ func ExampleConnection_GetTotalTime() {
 	fmt.Println("GetTotalTime")
 	fmt.Println("Total Hours:", totalTime.TotalHoursSum)
 	fmt.Println("Total Hours Billable:", totalTime.BillableHoursSum)
+	fmt.Println("Non Billable:", totalTime.Total()-totalTime.Billable())
+	// Output:
+	// GetTotalTime
+	// Total Hours: 3.50
+	// Total Hours Billable: 2.50
+	// Non Billable: 1h0m0s
 }
 
 func ExampleConnection_GetProjectTotalTime() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetProjectTotalTime")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -131,13 +169,20 @@ func ExampleConnection_GetProjectTotalTime() {
 	fmt.Println("Name:", projectTotalTime[0].Name)
 	fmt.Println("Total Hours:", projectTotalTime[0].TimeTotals.TotalHoursSum)
 	fmt.Println("Total Hours Billable:", projectTotalTime[0].TimeTotals.BillableHoursSum)
+	fmt.Println("Total:", projectTotalTime[0].TimeTotals.Total())
+	// Output:
+	// GetProjectTotalTime
+	// Name: Website Redesign
+	// Total Hours: 3.50
+	// Total Hours Billable: 2.50
+	// Total: 3h30m0s
 }
 
 func ExampleConnection_GetTaskListTotalTime() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetTaskListTotalTime")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -154,13 +199,18 @@ func ExampleConnection_GetTaskListTotalTime() {
 	fmt.Println("Name:", taskListTotalTime[0].TaskList.Name)
 	fmt.Println("Total Hours:", taskListTotalTime[0].TaskList.TimeTotals.TotalHoursSum)
 	fmt.Println("Total Hours Billable:", taskListTotalTime[0].TaskList.TimeTotals.BillableHoursSum)
+	// Output:
+	// GetTaskListTotalTime
+	// Name: Design
+	// Total Hours: 3.50
+	// Total Hours Billable: 2.50
 }
 
 func ExampleConnection_GetTaskTotalTime() {
-	// setup the teamwork connection
-	baseURL := "a teamwork baseURL"
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
 	apiToken := "a_teamwork_apiToken"
-	conn, err := teamwork.Connect(baseURL, apiToken)
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetTaskTotalTime")))
 	if err != nil {
 		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
 		os.Exit(1)
@@ -177,4 +227,226 @@ func ExampleConnection_GetTaskTotalTime() {
 	fmt.Println("Name:", taskTotalTime[0].TaskList.Task.Name)
 	fmt.Println("Total Hours:", taskTotalTime[0].TaskList.Task.TimeTotals.TotalHoursSum)
 	fmt.Println("Total Hours Billable:", taskTotalTime[0].TaskList.Task.TimeTotals.BillableHoursSum)
+	// Output:
+	// GetTaskTotalTime
+	// Name: Draft the wireframes
+	// Total Hours: 2.50
+	// Total Hours Billable: 2.50
+}
+
+func ExampleConnection_GetTimeEntriesContext() {
+	// setup the teamwork connection, replaying the calls recorded in testdata
+	baseURL := "https://example.teamwork.com"
+	apiToken := "a_teamwork_apiToken"
+	conn, err := teamwork.Connect(baseURL, apiToken, teamwork.WithHTTPClient(cassette("ExampleConnection_GetTimeEntriesContext")))
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// give up on the time entries if they take longer than 10 seconds
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	timeEntries, pages, err := conn.GetTimeEntriesContext(ctx, &teamwork.GetTimeEntriesOps{})
+	if err != nil {
+		fmt.Printf("Error getting Time Entries: %s", err.Error())
+	}
+
+	fmt.Println("GetTimeEntriesContext")
+	fmt.Println("1. Time for Project Name:", timeEntries[0].ProjectName)
+	fmt.Println("# of records:", pages.Records)
+	// Output:
+	// GetTimeEntriesContext
+	// 1. Time for Project Name: Website Redesign
+	// # of records: 2
+}
+
+func ExampleConnection_AllTimeEntries() {
+	// a stand-in for TeamWork with 3 pages of 2 time entries
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		w.Header().Set("X-Page", page)
+		w.Header().Set("X-Pages", "3")
+		w.Header().Set("X-Records", "6")
+		fmt.Fprintf(w, `{"time-entries": [{"id": "%s1"}, {"id": "%s2"}], "STATUS": "OK"}`, page, page)
+	}))
+	defer server.Close()
+
+	// setup the teamwork connection
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken")
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// walk the time entries until the one we are looking for
+	for timeEntry, err := range conn.AllTimeEntries(context.Background(), &teamwork.GetTimeEntriesOps{}) {
+		if err != nil {
+			fmt.Printf("Error getting Time Entries: %s", err.Error())
+			break
+		}
+		fmt.Println("Time Entry:", timeEntry.ID)
+		if timeEntry.ID == "21" {
+			break
+		}
+	}
+
+	// or get all of them at once
+	timeEntries, err := conn.GetAllTimeEntries(&teamwork.GetTimeEntriesOps{})
+	if err != nil {
+		fmt.Printf("Error getting Time Entries: %s", err.Error())
+	}
+	fmt.Println("# of records:", len(timeEntries))
+	// Output:
+	// Time Entry: 11
+	// Time Entry: 12
+	// Time Entry: 21
+	// # of records: 6
+}
+
+func ExampleConnection_GetAllTimeEntries() {
+	// a stand-in for TeamWork with 5 pages of 1 time entry
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate.json" {
+			fmt.Fprintf(w, `{"account": {"URL": "%s/"}, "STATUS": "OK"}`, server.URL)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		w.Header().Set("X-Page", page)
+		w.Header().Set("X-Pages", "5")
+		w.Header().Set("X-Records", "5")
+		fmt.Fprintf(w, `{"time-entries": [{"id": "%s"}], "STATUS": "OK"}`, page)
+	}))
+	defer server.Close()
+
+	// setup the teamwork connection to fetch up to 3 pages at a time
+	conn, err := teamwork.Connect(server.URL, "a_teamwork_apiToken",
+		teamwork.WithPageConcurrency(3),
+	)
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// the time entries are returned in page order
+	timeEntries, err := conn.GetAllTimeEntries(&teamwork.GetTimeEntriesOps{})
+	if err != nil {
+		fmt.Printf("Error getting Time Entries: %s", err.Error())
+	}
+	for _, timeEntry := range timeEntries {
+		fmt.Println("Time Entry:", timeEntry.ID)
+	}
+	// Output:
+	// Time Entry: 1
+	// Time Entry: 2
+	// Time Entry: 3
+	// Time Entry: 4
+	// Time Entry: 5
+}
+
+func ExampleConnection_UpdateTimeEntry() {
+	// a fake TeamWork to log the time in
+	server := teamworktest.NewServer()
+	defer server.Close()
+
+	// setup the teamwork connection
+	conn, err := server.Connect()
+	if err != nil {
+		fmt.Printf("Error connecting to TeamWork: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// log some time against a task
+	createResponse, err := conn.CreateTimeEntryForTask("4486838", &teamwork.CreateTimeEntryOps{
+		Description: "Wireframe review",
+		PersonID:    "85457",
+		Date:        "20200603",
+		Time:        "09:00:00",
+		Hours:       "1",
+		Minutes:     "0",
+	})
+	if err != nil {
+		fmt.Printf("Error creating Time Entry: %s", err.Error())
+		os.Exit(1)
+	}
+
+	// it took longer than that, so correct it in place
+	_, err = conn.UpdateTimeEntry(createResponse.ID.String(), &teamwork.UpdateTimeEntryOps{
+		Hours:   "2",
+		Minutes: "30",
+	})
+	if err != nil {
+		fmt.Printf("Error updating Time Entry: %s", err.Error())
+	}
+
+	timeEntry, err := conn.GetTimeEntry(createResponse.ID.String())
+	if err != nil {
+		fmt.Printf("Error getting Time Entry: %s", err.Error())
+	}
+	fmt.Println("Same ID:", timeEntry.ID == createResponse.ID)
+	fmt.Println("Description:", timeEntry.Description)
+	fmt.Printf("Time: %sh %sm\n", timeEntry.Hours, timeEntry.Minutes)
+	// Output:
+	// Same ID: true
+	// Description: Wireframe review
+	// Time: 2h 30m
+}
+
+func TestTimeEntryRequests(t *testing.T) {
+	conn := connectTo(t,
+		apiCall{
+			method:   "POST",
+			path:     "/tasks/4486838/time_entries.json",
+			body:     `{"time-entry": {"description": "Wireframe review", "person-id": "85457", "date": "20200603", "time": "09:00:00", "hours": "1", "minutes": "0", "isbillable": "1"}}`,
+			response: `{"time-entry": {"timeLogId": 15151515}, "STATUS": "OK"}`,
+		},
+		apiCall{
+			method:   "POST",
+			path:     "/projects/158721/time_entries.json",
+			body:     `{"time-entry": {"description": "Planning", "person-id": "85457", "date": "20200604", "time": "10:00:00", "hours": "0", "minutes": "45"}}`,
+			response: `{"time-entry": {"timeLogId": "15151516"}, "STATUS": "OK"}`,
+		},
+		apiCall{
+			method: "PUT",
+			path:   "/time_entries/15151515.json",
+			body:   `{"time-entry": {"hours": "2", "minutes": "30", "tags": "review"}}`,
+		},
+		apiCall{method: "DELETE", path: "/time_entries/15151516.json"},
+	)
+
+	taskResponse, err := conn.CreateTimeEntryForTask("4486838", &teamwork.CreateTimeEntryOps{
+		Description: "Wireframe review",
+		PersonID:    "85457",
+		Date:        "20200603",
+		Time:        "09:00:00",
+		Hours:       "1",
+		Minutes:     "0",
+		IsBillable:  "1",
+	})
+	if err != nil || taskResponse.ID != "15151515" {
+		t.Errorf("CreateTimeEntryForTask() = %+v, %v, want 15151515", taskResponse, err)
+	}
+	projectResponse, err := conn.CreateTimeEntryForProject("158721", &teamwork.CreateTimeEntryOps{
+		Description: "Planning",
+		PersonID:    "85457",
+		Date:        "20200604",
+		Time:        "10:00:00",
+		Hours:       "0",
+		Minutes:     "45",
+	})
+	if err != nil || projectResponse.ID != "15151516" {
+		t.Errorf("CreateTimeEntryForProject() = %+v, %v, want 15151516", projectResponse, err)
+	}
+	if _, err := conn.UpdateTimeEntry("15151515", &teamwork.UpdateTimeEntryOps{Hours: "2", Minutes: "30", Tags: "review"}); err != nil {
+		t.Errorf("UpdateTimeEntry() error = %v", err)
+	}
+	if _, err := conn.DeleteTimeEntry("15151516"); err != nil {
+		t.Errorf("DeleteTimeEntry() error = %v", err)
+	}
 }